@@ -6,6 +6,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/go-github/github"
@@ -13,18 +14,19 @@ import (
 
 func main() {
 	client := github.NewClient(nil)
+	ctx := context.Background()
 
 	fmt.Println("Recently updated repositories owned by user willnorris:")
 
 	opt := &github.RepositoryListOptions{Type: "owner", Sort: "updated", Direction: "desc"}
-	repos, _, err := client.Repositories.List("willnorris", opt)
+	repos, _, err := client.Repositories.List(ctx, "willnorris", opt)
 	if err != nil {
 		fmt.Printf("error: %v\n\n", err)
 	} else {
 		fmt.Printf("%v\n\n", github.Stringify(repos))
 	}
 
-	rate, _, err := client.RateLimit()
+	rate, _, err := client.RateLimit(ctx)
 	if err != nil {
 		fmt.Printf("Error fetching rate limit: %#v\n\n", err)
 	} else {
@@ -6,10 +6,10 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -29,16 +29,57 @@ func (e Event) String() string {
 	return Stringify(e)
 }
 
-// Payload returns the parsed event payload. For recognized event types
-// (PushEvent), a value of the corresponding struct type will be returned.
-func (e *Event) Payload() (payload interface{}) {
-	switch *e.Type {
-	case "PushEvent":
-		payload = &PushEvent{}
+// eventPayloads maps the types of timeline events to functions returning a
+// new value of their payload type.
+var eventPayloads = map[string]func() interface{}{
+	"CommitCommentEvent":            func() interface{} { return new(CommitCommentEvent) },
+	"CreateEvent":                   func() interface{} { return new(CreateEvent) },
+	"DeleteEvent":                   func() interface{} { return new(DeleteEvent) },
+	"FollowEvent":                   func() interface{} { return new(FollowEvent) },
+	"ForkEvent":                     func() interface{} { return new(ForkEvent) },
+	"ForkApplyEvent":                func() interface{} { return new(ForkApplyEvent) },
+	"GistEvent":                     func() interface{} { return new(GistEvent) },
+	"GollumEvent":                   func() interface{} { return new(GollumEvent) },
+	"IssueCommentEvent":             func() interface{} { return new(IssueCommentEvent) },
+	"IssuesEvent":                   func() interface{} { return new(IssuesEvent) },
+	"MemberEvent":                   func() interface{} { return new(MemberEvent) },
+	"PublicEvent":                   func() interface{} { return new(PublicEvent) },
+	"PullRequestEvent":              func() interface{} { return new(PullRequestEvent) },
+	"PullRequestReviewCommentEvent": func() interface{} { return new(PullRequestReviewCommentEvent) },
+	"PushEvent":                     func() interface{} { return new(PushEvent) },
+	"ReleaseEvent":                  func() interface{} { return new(ReleaseEvent) },
+	"StatusEvent":                   func() interface{} { return new(StatusEvent) },
+	"TeamAddEvent":                  func() interface{} { return new(TeamAddEvent) },
+	"WatchEvent":                    func() interface{} { return new(WatchEvent) },
+}
+
+// ParsePayload parses the event payload.  For recognized event types, such as
+// PushEvent or IssuesEvent, a pointer to a value of the struct type of the
+// same name is returned.  Payloads of other types, or of events without a
+// type, are decoded into an interface{} value, so that new event types are
+// not discarded.  An error is returned if the payload is missing or is not
+// valid JSON for its type.
+func (e *Event) ParsePayload() (payload interface{}, err error) {
+	if e.RawPayload == nil {
+		return nil, errors.New("github: event has no payload")
+	}
+	if e.Type != nil {
+		if newPayload, ok := eventPayloads[*e.Type]; ok {
+			payload = newPayload()
+		}
 	}
 	if err := json.Unmarshal(*e.RawPayload, &payload); err != nil {
-		panic(err.Error())
+		return nil, err
 	}
+	return payload, nil
+}
+
+// Payload returns the parsed event payload like ParsePayload, or nil if it
+// cannot be parsed.
+//
+// Deprecated: Use ParsePayload, which reports why parsing failed.
+func (e *Event) Payload() (payload interface{}) {
+	payload, _ = e.ParsePayload()
 	return payload
 }
 
@@ -49,7 +90,7 @@ type PushEvent struct {
 	PushID  *int              `json:"push_id,omitempty"`
 	Head    *string           `json:"head,omitempty"`
 	Ref     *string           `json:"ref,omitempty"`
-	Size    *int              `json:"ref,omitempty"`
+	Size    *int              `json:"size,omitempty"`
 	Commits []PushEventCommit `json:"commits,omitempty"`
 }
 
@@ -73,13 +114,11 @@ func (p PushEventCommit) String() string {
 // ListEvents drinks from the firehose of all public events across GitHub.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-public-events
-func (s *ActivityService) ListEvents(opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListEvents(ctx context.Context, opt *ListOptions) ([]Event, *Response, error) {
 	u := "events"
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -88,20 +127,18 @@ func (s *ActivityService) ListEvents(opt *ListOptions) ([]Event, *Response, erro
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
 // ListRepositoryEvents lists events for a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-repository-events
-func (s *ActivityService) ListRepositoryEvents(owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/events", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -110,20 +147,18 @@ func (s *ActivityService) ListRepositoryEvents(owner, repo string, opt *ListOpti
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
 // ListIssueEventsForRepository lists issue events for a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-issue-events-for-a-repository
-func (s *ActivityService) ListIssueEventsForRepository(owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListIssueEventsForRepository(ctx context.Context, owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/events", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -132,20 +167,18 @@ func (s *ActivityService) ListIssueEventsForRepository(owner, repo string, opt *
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
 // ListEventsForRepoNetwork lists public events for a network of repositories.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-public-events-for-a-network-of-repositories
-func (s *ActivityService) ListEventsForRepoNetwork(owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner, repo string, opt *ListOptions) ([]Event, *Response, error) {
 	u := fmt.Sprintf("networks/%v/%v/events", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -154,20 +187,18 @@ func (s *ActivityService) ListEventsForRepoNetwork(owner, repo string, opt *List
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
 // ListEventsForOrganization lists public events for an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-public-events-for-an-organization
-func (s *ActivityService) ListEventsForOrganization(org string, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListEventsForOrganization(ctx context.Context, org string, opt *ListOptions) ([]Event, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/events", org)
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -176,7 +207,7 @@ func (s *ActivityService) ListEventsForOrganization(org string, opt *ListOptions
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
@@ -184,7 +215,7 @@ func (s *ActivityService) ListEventsForOrganization(org string, opt *ListOptions
 // true, only public events will be returned.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-events-performed-by-a-user
-func (s *ActivityService) ListEventsPerformedByUser(user string, publicOnly bool, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListEventsPerformedByUser(ctx context.Context, user string, publicOnly bool, opt *ListOptions) ([]Event, *Response, error) {
 	var u string
 	if publicOnly {
 		u = fmt.Sprintf("users/%v/events/public", user)
@@ -192,11 +223,9 @@ func (s *ActivityService) ListEventsPerformedByUser(user string, publicOnly bool
 		u = fmt.Sprintf("users/%v/events", user)
 	}
 
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -205,7 +234,7 @@ func (s *ActivityService) ListEventsPerformedByUser(user string, publicOnly bool
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
@@ -213,7 +242,7 @@ func (s *ActivityService) ListEventsPerformedByUser(user string, publicOnly bool
 // true, only public events will be returned.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-events-that-a-user-has-received
-func (s *ActivityService) ListEventsRecievedByUser(user string, publicOnly bool, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListEventsRecievedByUser(ctx context.Context, user string, publicOnly bool, opt *ListOptions) ([]Event, *Response, error) {
 	var u string
 	if publicOnly {
 		u = fmt.Sprintf("users/%v/received_events/public", user)
@@ -221,11 +250,9 @@ func (s *ActivityService) ListEventsRecievedByUser(user string, publicOnly bool,
 		u = fmt.Sprintf("users/%v/received_events", user)
 	}
 
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -234,7 +261,7 @@ func (s *ActivityService) ListEventsRecievedByUser(user string, publicOnly bool,
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
 
@@ -242,13 +269,11 @@ func (s *ActivityService) ListEventsRecievedByUser(user string, publicOnly bool,
 // must be authenticated as the user to view this.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/events/#list-events-for-an-organization
-func (s *ActivityService) ListUserEventsForOrganization(org, user string, opt *ListOptions) ([]Event, *Response, error) {
+func (s *ActivityService) ListUserEventsForOrganization(ctx context.Context, org, user string, opt *ListOptions) ([]Event, *Response, error) {
 	u := fmt.Sprintf("users/%v/events/orgs/%v", user, org)
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -256,6 +281,6 @@ func (s *ActivityService) ListUserEventsForOrganization(org, user string, opt *L
 	}
 
 	events := new([]Event)
-	resp, err := s.client.Do(req, events)
+	resp, err := s.client.Do(ctx, req, events)
 	return *events, resp, err
 }
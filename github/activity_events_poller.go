@@ -0,0 +1,212 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultPollInterval is used until GitHub sends an X-Poll-Interval.
+	defaultPollInterval = 60 * time.Second
+
+	// maxSeenEvents is the number of event IDs an EventPoller remembers.
+	// GitHub lists at most 300 events, so older ones cannot reappear.
+	maxSeenEvents = 1000
+)
+
+/*
+An EventPoller tails a list of events, returning each event once.
+
+Every poll requests the first page of events conditionally, so that polls
+finding nothing new answer 304 Not Modified and do not count against the rate
+limit.  When there are new events, the poller pages back until it reaches an
+event it has already returned.  The first poll returns only the first page.
+
+Run polls at the interval GitHub asks for in the X-Poll-Interval header, and
+sends new events over a channel until its context is canceled:
+
+	p := client.Activity.NewRepositoryEventPoller("o", "r")
+	events := make(chan github.Event)
+	go func() {
+		for e := range events {
+			// ...
+		}
+	}()
+	err := p.Run(ctx, events)
+
+An EventPoller must not be used concurrently.
+
+GitHub API docs: http://developer.github.com/v3/activity/events/
+*/
+type EventPoller struct {
+	client *Client
+	urlStr string
+
+	// MinInterval is the minimum time Run waits between polls.  The interval
+	// requested by GitHub is used if it is longer.
+	MinInterval time.Duration
+
+	started  bool
+	etag     string
+	interval time.Duration
+	seen     map[string]bool
+	order    []string // seen IDs, oldest first
+}
+
+// NewRepositoryEventPoller returns an EventPoller for the events of a
+// repository, as listed by ListRepositoryEvents.
+func (s *ActivityService) NewRepositoryEventPoller(owner, repo string) *EventPoller {
+	return s.newEventPoller(fmt.Sprintf("repos/%v/%v/events", owner, repo))
+}
+
+// NewOrganizationEventPoller returns an EventPoller for the public events of
+// an organization, as listed by ListEventsForOrganization.
+func (s *ActivityService) NewOrganizationEventPoller(org string) *EventPoller {
+	return s.newEventPoller(fmt.Sprintf("orgs/%v/events", org))
+}
+
+func (s *ActivityService) newEventPoller(urlStr string) *EventPoller {
+	return &EventPoller{
+		client:   s.client,
+		urlStr:   urlStr,
+		interval: defaultPollInterval,
+		seen:     make(map[string]bool),
+	}
+}
+
+// Poll returns the events created since the previous poll, oldest first.  The
+// returned Response is the one for the first page.
+func (p *EventPoller) Poll(ctx context.Context) ([]Event, *Response, error) {
+	var (
+		first  *Response
+		etag   string
+		events []Event // newest first
+		inPoll = make(map[string]bool)
+	)
+	for page := 1; page != 0; {
+		u := p.urlStr
+		if page > 1 {
+			var err error
+			if u, err = addOptions(u, &ListOptions{Page: page}); err != nil {
+				return nil, first, err
+			}
+		}
+		req, err := p.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, first, err
+		}
+		if page == 1 && p.etag != "" {
+			req.Header.Set(headerIfNoneMatch, p.etag)
+		}
+
+		var list []Event
+		resp, err := p.client.Do(ctx, req, &list)
+		if page == 1 && resp != nil {
+			first = resp
+			if resp.PollInterval > 0 {
+				p.interval = resp.PollInterval
+			}
+			if resp.StatusCode == http.StatusNotModified {
+				return nil, resp, nil
+			}
+			etag = resp.Header.Get(headerETag)
+		}
+		if err != nil {
+			return nil, first, err
+		}
+
+		reachedSeen := false
+		for _, e := range list {
+			if e.ID == nil {
+				events = append(events, e)
+				continue
+			}
+			if p.seen[*e.ID] {
+				reachedSeen = true
+				continue
+			}
+			// Events shift to later pages as new ones are created, so the
+			// same event may be listed twice in one poll.
+			if !inPoll[*e.ID] {
+				inPoll[*e.ID] = true
+				events = append(events, e)
+			}
+		}
+
+		page = resp.NextPage
+		if !p.started || reachedSeen {
+			break
+		}
+	}
+
+	// Reverse the events, and remember their IDs.
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	for _, e := range events {
+		if e.ID != nil {
+			p.markSeen(*e.ID)
+		}
+	}
+	p.started = true
+	p.etag = etag
+	return events, first, nil
+}
+
+// Run polls until ctx is canceled or a poll fails, sending new events to ch in
+// the order they were created.  It waits between polls for the interval
+// requested by GitHub, or MinInterval if longer.  It returns ctx.Err() once
+// ctx is canceled, or the error of the failed poll; Run can then be called
+// again to resume polling.
+func (p *EventPoller) Run(ctx context.Context, ch chan<- Event) error {
+	for {
+		events, _, err := p.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := waitPoll(ctx, p.interval, p.MinInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// waitPoll waits for the poll interval requested by GitHub, or min if
+// longer.  It returns ctx.Err() if ctx is canceled first.
+func waitPoll(ctx context.Context, interval, min time.Duration) error {
+	if interval < min {
+		interval = min
+	}
+	t := time.NewTimer(interval)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// markSeen records that the event with the given ID has been returned,
+// forgetting the oldest IDs beyond maxSeenEvents.
+func (p *EventPoller) markSeen(id string) {
+	p.seen[id] = true
+	p.order = append(p.order, id)
+	for len(p.order) > maxSeenEvents {
+		delete(p.seen, p.order[0])
+		p.order = p.order[1:]
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// eventIDs returns the IDs of events.
+func eventIDs(events []Event) []string {
+	ids := []string{}
+	for _, e := range events {
+		ids = append(ids, *e.ID)
+	}
+	return ids
+}
+
+func TestEventPoller_Poll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// pages holds the events listed on each page, newest first.
+	var pages [][]string
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		etag := fmt.Sprintf(`"%v"`, pages[0][0])
+		page := 1
+		fmt.Sscan(r.FormValue("page"), &page)
+		if page == 1 {
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		w.Header().Set("X-Poll-Interval", "30")
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<%v/repos/o/r/events?page=%d>; rel="next"`, server.URL, page+1))
+		}
+		fmt.Fprint(w, "[")
+		for i, id := range pages[page-1] {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id":%q}`, id)
+		}
+		fmt.Fprint(w, "]")
+	})
+
+	p := client.Activity.NewRepositoryEventPoller("o", "r")
+	poll := func(want ...string) {
+		events, _, err := p.Poll(context.Background())
+		if err != nil {
+			t.Fatalf("Poll returned error: %v", err)
+		}
+		if want == nil {
+			want = []string{}
+		}
+		if got := eventIDs(events); !reflect.DeepEqual(got, want) {
+			t.Errorf("Poll returned events %v, want %v", got, want)
+		}
+	}
+
+	// The first poll returns only the first page.
+	pages = [][]string{{"4", "3"}, {"2", "1"}}
+	poll("3", "4")
+	if p.interval != 30*time.Second {
+		t.Errorf("poll interval = %v, want 30s", p.interval)
+	}
+
+	// Nothing changed: the conditional request answers 304 Not Modified.
+	poll()
+
+	// New events push older ones to later pages, which are fetched until a
+	// seen event is reached.  Event 5 shifted to the second page is not
+	// returned twice.
+	pages = [][]string{{"8", "7", "6", "5"}, {"5", "4", "3"}, {"2", "1"}}
+	poll("5", "6", "7", "8")
+	poll()
+}
+
+func TestEventPoller_Run(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan Event)
+	done := make(chan error)
+	go func() {
+		done <- client.Activity.NewOrganizationEventPoller("o").Run(ctx, ch)
+	}()
+
+	var got []Event
+	for i := 0; i < 2; i++ {
+		got = append(got, <-ch)
+	}
+	if ids := eventIDs(got); !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("Run sent events %v, want [1 2]", ids)
+	}
+
+	// Run is now waiting for the next poll, and stops when canceled.
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestEventPoller_Run_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/events", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	err := client.Activity.NewOrganizationEventPoller("o").Run(context.Background(), make(chan Event))
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("Run returned %v, want *NotFoundError", err)
+	}
+}
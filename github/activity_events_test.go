@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,7 +27,7 @@ func TestActivityService_ListEvents(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListEvents(opt)
+	events, _, err := client.Activity.ListEvents(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Activities.ListEvents returned error: %v", err)
 	}
@@ -50,7 +51,7 @@ func TestActivityService_ListRepositoryEvents(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListRepositoryEvents("o", "r", opt)
+	events, _, err := client.Activity.ListRepositoryEvents(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Activities.ListRepositoryEvents returned error: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestActivityService_ListIssueEventsForRepository(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListIssueEventsForRepository("o", "r", opt)
+	events, _, err := client.Activity.ListIssueEventsForRepository(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Activities.ListIssueEventsForRepository returned error: %v", err)
 	}
@@ -98,7 +99,7 @@ func TestActivityService_ListEventsForRepoNetwork(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListEventsForRepoNetwork("o", "r", opt)
+	events, _, err := client.Activity.ListEventsForRepoNetwork(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Activities.ListEventsForRepoNetwork returned error: %v", err)
 	}
@@ -122,7 +123,7 @@ func TestActivityService_ListEventsForOrganization(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListEventsForOrganization("o", opt)
+	events, _, err := client.Activity.ListEventsForOrganization(context.Background(), "o", opt)
 	if err != nil {
 		t.Errorf("Activities.ListEventsForOrganization returned error: %v", err)
 	}
@@ -146,7 +147,7 @@ func TestActivityService_ListEventsPerformedByUser_all(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListEventsPerformedByUser("u", false, opt)
+	events, _, err := client.Activity.ListEventsPerformedByUser(context.Background(), "u", false, opt)
 	if err != nil {
 		t.Errorf("Events.ListPerformedByUser returned error: %v", err)
 	}
@@ -166,7 +167,7 @@ func TestActivityService_ListEventsPerformedByUser_publicOnly(t *testing.T) {
 		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
 	})
 
-	events, _, err := client.Activity.ListEventsPerformedByUser("u", true, nil)
+	events, _, err := client.Activity.ListEventsPerformedByUser(context.Background(), "u", true, nil)
 	if err != nil {
 		t.Errorf("Events.ListPerformedByUser returned error: %v", err)
 	}
@@ -190,7 +191,7 @@ func TestActivityService_ListEventsRecievedByUser_all(t *testing.T) {
 	})
 
 	opt := &ListOptions{Page: 2}
-	events, _, err := client.Activity.ListEventsRecievedByUser("u", false, opt)
+	events, _, err := client.Activity.ListEventsRecievedByUser(context.Background(), "u", false, opt)
 	if err != nil {
 		t.Errorf("Events.ListRecievedByUser returned error: %v", err)
 	}
@@ -210,7 +211,7 @@ func TestActivityService_ListEventsRecievedByUser_publicOnly(t *testing.T) {
 		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
 	})
 
-	events, _, err := client.Activity.ListEventsRecievedByUser("u", true, nil)
+	events, _, err := client.Activity.ListEventsRecievedByUser(context.Background(), "u", true, nil)
 	if err != nil {
 		t.Errorf("Events.ListRecievedByUser returned error: %v", err)
 	}
@@ -230,7 +231,7 @@ func TestActivityService_ListUserEventsForOrganization(t *testing.T) {
 		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
 	})
 
-	events, _, err := client.Activity.ListUserEventsForOrganization("o", "u", nil)
+	events, _, err := client.Activity.ListUserEventsForOrganization(context.Background(), "o", "u", nil)
 	if err != nil {
 		t.Errorf("Activities.ListUserEventsForOrganization returned error: %v", err)
 	}
@@ -269,3 +270,51 @@ func TestActivity_EventPayload_untyped(t *testing.T) {
 		t.Errorf("Event Payload returned %+v, want %+v", event.Payload(), want)
 	}
 }
+
+func TestEvent_ParsePayload(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want interface{}
+	}{
+		{`{"type":"PushEvent","payload":{"push_id":1,"size":2}}`, &PushEvent{PushID: Int(1), Size: Int(2)}},
+		{`{"type":"CreateEvent","payload":{"ref":"b","ref_type":"branch"}}`, &CreateEvent{Ref: String("b"), RefType: String("branch")}},
+		{`{"type":"ForkEvent","payload":{"forkee":{"id":1}}}`, &ForkEvent{Forkee: &Repository{ID: Int(1)}}},
+		{`{"type":"GollumEvent","payload":{"pages":[{"page_name":"p","action":"created"}]}}`, &GollumEvent{Pages: []Page{{PageName: String("p"), Action: String("created")}}}},
+		{`{"type":"MemberEvent","payload":{"action":"added","member":{"login":"u"}}}`, &MemberEvent{Action: String("added"), Member: &User{Login: String("u")}}},
+		{`{"type":"ReleaseEvent","payload":{"action":"published","release":{"tag_name":"v1"}}}`, &ReleaseEvent{Action: String("published"), Release: &RepositoryRelease{TagName: String("v1")}}},
+		{`{"type":"WatchEvent","payload":{"action":"started"}}`, &WatchEvent{Action: String("started")}},
+		{`{"payload":{"field":"val"}}`, map[string]interface{}{"field": "val"}},
+	}
+	for _, tt := range tests {
+		event := new(Event)
+		if err := json.Unmarshal([]byte(tt.raw), event); err != nil {
+			t.Fatalf("Unmarshal Event returned error: %v", err)
+		}
+		got, err := event.ParsePayload()
+		if err != nil {
+			t.Errorf("ParsePayload(%v) returned error: %v", tt.raw, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePayload(%v) returned %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestEvent_ParsePayload_invalid(t *testing.T) {
+	for _, raw := range []string{
+		`{"type":"PushEvent"}`,
+		`{"type":"PushEvent","payload":{"push_id":"not a number"}}`,
+		`{"type":"IssuesEvent","payload":[]}`,
+	} {
+		event := new(Event)
+		if err := json.Unmarshal([]byte(raw), event); err != nil {
+			t.Fatalf("Unmarshal Event returned error: %v", err)
+		}
+		if _, err := event.ParsePayload(); err == nil {
+			t.Errorf("ParsePayload(%v) returned no error", raw)
+		}
+		if p := event.Payload(); p != nil {
+			t.Errorf("Payload(%v) returned %+v, want nil", raw, p)
+		}
+	}
+}
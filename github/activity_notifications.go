@@ -0,0 +1,404 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Notification identifies a GitHub notification for a user.
+type Notification struct {
+	ID         *string              `json:"id,omitempty"`
+	Repository *Repository          `json:"repository,omitempty"`
+	Subject    *NotificationSubject `json:"subject,omitempty"`
+
+	// Reason identifies the event that triggered the notification.  Possible
+	// values are: subscribed, manual, author, comment, mention, team_mention,
+	// state_change, assign.
+	//
+	// GitHub API docs: http://developer.github.com/v3/activity/notifications/#notification-reasons
+	Reason *string `json:"reason,omitempty"`
+
+	Unread     *bool      `json:"unread,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+	URL        *string    `json:"url,omitempty"`
+}
+
+func (n Notification) String() string {
+	return Stringify(n)
+}
+
+// NotificationSubject identifies the subject of a notification.
+type NotificationSubject struct {
+	Title            *string `json:"title,omitempty"`
+	URL              *string `json:"url,omitempty"`
+	LatestCommentURL *string `json:"latest_comment_url,omitempty"`
+
+	// Type is the type of the subject.  Possible values are: Issue,
+	// PullRequest, Commit, Release.
+	Type *string `json:"type,omitempty"`
+}
+
+func (n NotificationSubject) String() string {
+	return Stringify(n)
+}
+
+// NotificationListOptions specifies the optional parameters to the
+// ActivityService.ListNotifications and
+// ActivityService.ListRepositoryNotifications methods.
+type NotificationListOptions struct {
+	// All, if true, lists notifications already marked as read.
+	All bool `url:"all,omitempty"`
+
+	// Participating, if true, only lists notifications in which the user is
+	// directly participating or mentioned.
+	Participating bool `url:"participating,omitempty"`
+
+	// Since only lists notifications updated after the given time.
+	Since time.Time `url:"since,omitempty"`
+
+	ListOptions
+}
+
+// Subscription identifies a repository or thread subscription.
+type Subscription struct {
+	// Subscribed, if true, delivers notifications from the subscribed thread
+	// or repository.
+	Subscribed *bool `json:"subscribed,omitempty"`
+
+	// Ignored, if true, blocks all notifications from the subscribed thread
+	// or repository.
+	Ignored *bool `json:"ignored,omitempty"`
+
+	Reason    *string    `json:"reason,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	URL       *string    `json:"url,omitempty"`
+
+	// only populated for thread subscriptions
+	ThreadURL *string `json:"thread_url,omitempty"`
+
+	// only populated for repository subscriptions
+	RepositoryURL *string `json:"repository_url,omitempty"`
+}
+
+func (s Subscription) String() string {
+	return Stringify(s)
+}
+
+// ListNotifications lists all notifications for the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#list-your-notifications
+func (s *ActivityService) ListNotifications(ctx context.Context, opt *NotificationListOptions) ([]Notification, *Response, error) {
+	u := "notifications"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notifications := new([]Notification)
+	resp, err := s.client.Do(ctx, req, notifications)
+	return *notifications, resp, err
+}
+
+// ListRepositoryNotifications lists all notifications in a given repository
+// for the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#list-your-notifications-in-a-repository
+func (s *ActivityService) ListRepositoryNotifications(ctx context.Context, owner, repo string, opt *NotificationListOptions) ([]Notification, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/notifications", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notifications := new([]Notification)
+	resp, err := s.client.Do(ctx, req, notifications)
+	return *notifications, resp, err
+}
+
+// markReadOptions is the body of a request to mark notifications as read.
+type markReadOptions struct {
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+}
+
+// newMarkReadOptions returns the body of a request to mark notifications
+// last updated before lastRead as read.  A zero lastRead means now.
+func newMarkReadOptions(lastRead time.Time) *markReadOptions {
+	opts := new(markReadOptions)
+	if !lastRead.IsZero() {
+		opts.LastReadAt = &lastRead
+	}
+	return opts
+}
+
+// MarkNotificationsRead marks all notifications last updated before lastRead
+// as read.  A zero lastRead marks all notifications as read.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#mark-as-read
+func (s *ActivityService) MarkNotificationsRead(ctx context.Context, lastRead time.Time) (*Response, error) {
+	req, err := s.client.NewRequest("PUT", "notifications", newMarkReadOptions(lastRead))
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// MarkRepositoryNotificationsRead marks all notifications in a given
+// repository last updated before lastRead as read.  A zero lastRead marks all
+// of them as read.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#mark-notifications-as-read-in-a-repository
+func (s *ActivityService) MarkRepositoryNotificationsRead(ctx context.Context, owner, repo string, lastRead time.Time) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/notifications", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, newMarkReadOptions(lastRead))
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetThread gets the specified notification thread.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#view-a-single-thread
+func (s *ActivityService) GetThread(ctx context.Context, id string) (*Notification, *Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v", id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notification := new(Notification)
+	resp, err := s.client.Do(ctx, req, notification)
+	return notification, resp, err
+}
+
+// MarkThreadRead marks the specified thread as read.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#mark-a-thread-as-read
+func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v", id)
+	req, err := s.client.NewRequest("PATCH", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetThreadSubscription checks to see if the authenticated user is subscribed
+// to a thread.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#get-a-thread-subscription
+func (s *ActivityService) GetThreadSubscription(ctx context.Context, id string) (*Subscription, *Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := new(Subscription)
+	resp, err := s.client.Do(ctx, req, sub)
+	return sub, resp, err
+}
+
+// SetThreadSubscription sets the subscription for the specified thread for the
+// authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#set-a-thread-subscription
+func (s *ActivityService) SetThreadSubscription(ctx context.Context, id string, subscription *Subscription) (*Subscription, *Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	req, err := s.client.NewRequest("PUT", u, subscription)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := new(Subscription)
+	resp, err := s.client.Do(ctx, req, sub)
+	return sub, resp, err
+}
+
+// DeleteThreadSubscription deletes the subscription for the specified thread
+// for the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/notifications/#delete-a-thread-subscription
+func (s *ActivityService) DeleteThreadSubscription(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+/*
+A NotificationPoller polls the notifications of the authenticated user.
+
+Every poll is conditional on the Last-Modified time of the previous one, so
+that polls finding nothing new answer 304 Not Modified and do not count
+against the rate limit.  A poll that finds changes reads every page of
+notifications matching the options, and returns those that are new or whose
+UpdatedAt changed since the previous poll, so a notification is returned
+again each time it is updated.  Run polls at the interval GitHub asks for in the
+X-Poll-Interval header:
+
+	p := client.Activity.NewNotificationPoller(nil)
+	notifications := make(chan github.Notification)
+	go func() {
+		for n := range notifications {
+			// ...
+		}
+	}()
+	err := p.Run(ctx, notifications)
+
+A NotificationPoller must not be used concurrently.
+
+GitHub API docs: http://developer.github.com/v3/activity/notifications/
+*/
+type NotificationPoller struct {
+	client *Client
+	urlStr string
+	opt    NotificationListOptions
+
+	// MinInterval is the minimum time Run waits between polls.  The interval
+	// requested by GitHub is used if it is longer.
+	MinInterval time.Duration
+
+	lastModified string
+	interval     time.Duration
+
+	// updated holds the UpdatedAt time of the notifications listed by the
+	// previous poll, by ID.
+	updated map[string]time.Time
+}
+
+// NewNotificationPoller returns a NotificationPoller for the notifications
+// of the authenticated user, as listed by ListNotifications with opt.
+// opt.ListOptions is ignored.
+func (s *ActivityService) NewNotificationPoller(opt *NotificationListOptions) *NotificationPoller {
+	return s.newNotificationPoller("notifications", opt)
+}
+
+// NewRepositoryNotificationPoller returns a NotificationPoller for the
+// notifications of the authenticated user in a repository, as listed by
+// ListRepositoryNotifications with opt.  opt.ListOptions is ignored.
+func (s *ActivityService) NewRepositoryNotificationPoller(owner, repo string, opt *NotificationListOptions) *NotificationPoller {
+	return s.newNotificationPoller(fmt.Sprintf("repos/%v/%v/notifications", owner, repo), opt)
+}
+
+func (s *ActivityService) newNotificationPoller(urlStr string, opt *NotificationListOptions) *NotificationPoller {
+	p := &NotificationPoller{client: s.client, urlStr: urlStr, interval: defaultPollInterval}
+	if opt != nil {
+		p.opt = *opt
+	}
+	return p
+}
+
+// Poll returns the notifications that are new or were updated since the
+// previous poll, or nil if there are none.  The returned Response is the one
+// for the first page.
+func (p *NotificationPoller) Poll(ctx context.Context) ([]Notification, *Response, error) {
+	var (
+		first         *Response
+		lastModified  string
+		notifications []Notification
+	)
+	for page := 1; page != 0; {
+		opt := p.opt
+		opt.ListOptions = ListOptions{Page: page, PerPage: 100}
+		u, err := addOptions(p.urlStr, &opt)
+		if err != nil {
+			return nil, first, err
+		}
+		req, err := p.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, first, err
+		}
+		if page == 1 && p.lastModified != "" {
+			req.Header.Set(headerIfModifiedSince, p.lastModified)
+		}
+
+		var list []Notification
+		resp, err := p.client.Do(ctx, req, &list)
+		if page == 1 && resp != nil {
+			first = resp
+			if resp.PollInterval > 0 {
+				p.interval = resp.PollInterval
+			}
+			if resp.StatusCode == http.StatusNotModified {
+				return nil, resp, nil
+			}
+			lastModified = resp.Header.Get(headerLastModified)
+		}
+		if err != nil {
+			return nil, first, err
+		}
+		notifications = append(notifications, list...)
+		page = resp.NextPage
+	}
+
+	p.lastModified = lastModified
+	return p.changed(notifications), first, nil
+}
+
+// changed returns the notifications that are not in p.updated with the same
+// UpdatedAt time, and replaces p.updated with the times of notifications.
+func (p *NotificationPoller) changed(notifications []Notification) []Notification {
+	updated := make(map[string]time.Time, len(notifications))
+	var changed []Notification
+	for _, n := range notifications {
+		if n.ID == nil {
+			changed = append(changed, n)
+			continue
+		}
+		var t time.Time
+		if n.UpdatedAt != nil {
+			t = *n.UpdatedAt
+		}
+		if last, ok := p.updated[*n.ID]; !ok || !last.Equal(t) {
+			changed = append(changed, n)
+		}
+		updated[*n.ID] = t
+	}
+	p.updated = updated
+	return changed
+}
+
+// Run polls until ctx is canceled or a poll fails, sending the notifications
+// returned by each poll to ch.  It waits between polls for the interval
+// requested by GitHub, or MinInterval if longer.  It returns ctx.Err() once
+// ctx is canceled, or the error of the failed poll; Run can then be called
+// again to resume polling.
+func (p *NotificationPoller) Run(ctx context.Context, ch chan<- Notification) error {
+	for {
+		notifications, _, err := p.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := waitPoll(ctx, p.interval, p.MinInterval); err != nil {
+			return err
+		}
+	}
+}
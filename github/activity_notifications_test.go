@@ -0,0 +1,306 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestActivityService_ListNotifications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"all":           "true",
+			"participating": "true",
+			"since":         "2006-01-02T15:04:05Z",
+		})
+
+		fmt.Fprint(w, `[{"id":"1", "subject":{"title":"t"}}]`)
+	})
+
+	opt := &NotificationListOptions{
+		All:           true,
+		Participating: true,
+		Since:         time.Date(2006, 01, 02, 15, 04, 05, 0, time.UTC),
+	}
+	notifications, _, err := client.Activity.ListNotifications(context.Background(), opt)
+	if err != nil {
+		t.Errorf("Activity.ListNotifications returned error: %v", err)
+	}
+
+	want := []Notification{{ID: String("1"), Subject: &NotificationSubject{Title: String("t")}}}
+	if !reflect.DeepEqual(notifications, want) {
+		t.Errorf("Activity.ListNotifications returned %+v, want %+v", notifications, want)
+	}
+}
+
+func TestActivityService_ListRepositoryNotifications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	notifications, _, err := client.Activity.ListRepositoryNotifications(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Activity.ListRepositoryNotifications returned error: %v", err)
+	}
+
+	want := []Notification{{ID: String("1")}}
+	if !reflect.DeepEqual(notifications, want) {
+		t.Errorf("Activity.ListRepositoryNotifications returned %+v, want %+v", notifications, want)
+	}
+}
+
+func TestActivityService_MarkNotificationsRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := new(markReadOptions)
+		json.NewDecoder(r.Body).Decode(v)
+		want := time.Date(2006, 01, 02, 15, 04, 05, 0, time.UTC)
+		if v.LastReadAt == nil || !v.LastReadAt.Equal(want) {
+			t.Errorf("Request body last_read_at = %v, want %v", v.LastReadAt, want)
+		}
+		w.WriteHeader(http.StatusResetContent)
+	})
+
+	_, err := client.Activity.MarkNotificationsRead(context.Background(), time.Date(2006, 01, 02, 15, 04, 05, 0, time.UTC))
+	if err != nil {
+		t.Errorf("Activity.MarkNotificationsRead returned error: %v", err)
+	}
+}
+
+func TestActivityService_MarkRepositoryNotificationsRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := new(markReadOptions)
+		json.NewDecoder(r.Body).Decode(v)
+		if v.LastReadAt != nil {
+			t.Errorf("Request body last_read_at = %v, want none", v.LastReadAt)
+		}
+		w.WriteHeader(http.StatusResetContent)
+	})
+
+	_, err := client.Activity.MarkRepositoryNotificationsRead(context.Background(), "o", "r", time.Time{})
+	if err != nil {
+		t.Errorf("Activity.MarkRepositoryNotificationsRead returned error: %v", err)
+	}
+}
+
+func TestActivityService_GetThread(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	notification, _, err := client.Activity.GetThread(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Activity.GetThread returned error: %v", err)
+	}
+
+	want := &Notification{ID: String("1")}
+	if !reflect.DeepEqual(notification, want) {
+		t.Errorf("Activity.GetThread returned %+v, want %+v", notification, want)
+	}
+}
+
+func TestActivityService_MarkThreadRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.WriteHeader(http.StatusResetContent)
+	})
+
+	_, err := client.Activity.MarkThreadRead(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Activity.MarkThreadRead returned error: %v", err)
+	}
+}
+
+func TestActivityService_GetThreadSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"subscribed":true}`)
+	})
+
+	sub, _, err := client.Activity.GetThreadSubscription(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Activity.GetThreadSubscription returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Bool(true)}
+	if !reflect.DeepEqual(sub, want) {
+		t.Errorf("Activity.GetThreadSubscription returned %+v, want %+v", sub, want)
+	}
+}
+
+func TestActivityService_SetThreadSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &Subscription{Subscribed: Bool(true)}
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		v := new(Subscription)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"ignored":true}`)
+	})
+
+	sub, _, err := client.Activity.SetThreadSubscription(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("Activity.SetThreadSubscription returned error: %v", err)
+	}
+
+	want := &Subscription{Ignored: Bool(true)}
+	if !reflect.DeepEqual(sub, want) {
+		t.Errorf("Activity.SetThreadSubscription returned %+v, want %+v", sub, want)
+	}
+}
+
+func TestActivityService_DeleteThreadSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Activity.DeleteThreadSubscription(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Activity.DeleteThreadSubscription returned error: %v", err)
+	}
+}
+
+func TestNotificationPoller_Poll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const lastModified = "Mon, 02 Jan 2006 15:04:05 GMT"
+	mux.HandleFunc("/repos/o/r/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"participating": "true", "per_page": "100"})
+		w.Header().Set("X-Poll-Interval", "30")
+		if r.FormValue("page") == "2" {
+			fmt.Fprint(w, `[{"id":"2"}]`)
+			return
+		}
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		w.Header().Set("Link", fmt.Sprintf(`<%v/repos/o/r/notifications?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	p := client.Activity.NewRepositoryNotificationPoller("o", "r", &NotificationListOptions{Participating: true})
+	notifications, resp, err := p.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	want := []Notification{{ID: String("1")}, {ID: String("2")}}
+	if !reflect.DeepEqual(notifications, want) {
+		t.Errorf("Poll returned %+v, want %+v", notifications, want)
+	}
+	if resp.PollInterval != 30*time.Second {
+		t.Errorf("Poll returned PollInterval %v, want 30s", resp.PollInterval)
+	}
+
+	notifications, _, err = p.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if notifications != nil {
+		t.Errorf("Poll returned %+v after no change, want nil", notifications)
+	}
+}
+
+func TestNotificationPoller_Run_noDuplicates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Every poll answers with a new Last-Modified time, as if
+	// If-Modified-Since always missed, but only the second and third change
+	// the notifications.
+	var polls int32
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&polls, 1)
+		w.Header().Set("Last-Modified", time.Unix(int64(n), 0).UTC().Format(http.TimeFormat))
+		switch n {
+		case 1:
+			fmt.Fprint(w, `[{"id":"1","updated_at":"2006-01-02T15:04:05Z"}]`)
+		case 2:
+			fmt.Fprint(w, `[{"id":"2","updated_at":"2006-01-02T15:04:05Z"},{"id":"1","updated_at":"2006-01-02T15:04:05Z"}]`)
+		default:
+			fmt.Fprint(w, `[{"id":"1","updated_at":"2006-01-02T16:04:05Z"},{"id":"2","updated_at":"2006-01-02T15:04:05Z"}]`)
+		}
+	})
+
+	p := client.Activity.NewNotificationPoller(nil)
+	p.interval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan Notification)
+	done := make(chan error)
+	go func() {
+		done <- p.Run(ctx, ch)
+	}()
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		n := <-ch
+		got = append(got, *n.ID+"@"+n.UpdatedAt.Format("15:04"))
+	}
+	if want := []string{"1@15:04", "2@15:04", "1@16:04"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Run sent notifications %v, want %v", got, want)
+	}
+
+	// Later polls return the same notifications, which are not sent again.
+	for atomic.LoadInt32(&polls) < 6 {
+		select {
+		case n := <-ch:
+			t.Errorf("Run sent unchanged notification %+v", n)
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run returned %v, want %v", err, context.Canceled)
+	}
+}
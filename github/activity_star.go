@@ -6,44 +6,106 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
 )
 
+// mimeStarredAt is the media type that adds the time a repository was starred
+// to the responses of the starring API.
+const mimeStarredAt = mimeV3 + "star+json"
+
+// StarredRepository is a repository starred by a user, with the time it was
+// starred.
+type StarredRepository struct {
+	StarredAt  *Timestamp  `json:"starred_at,omitempty"`
+	Repository *Repository `json:"repo,omitempty"`
+}
+
+func (s StarredRepository) String() string {
+	return Stringify(s)
+}
+
+// Stargazer is a user who starred a repository, with the time they starred it.
+type Stargazer struct {
+	StarredAt *Timestamp `json:"starred_at,omitempty"`
+	User      *User      `json:"user,omitempty"`
+}
+
+func (s Stargazer) String() string {
+	return Stringify(s)
+}
+
+// ListStargazers lists the users who have starred the specified repository.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#list-stargazers
+func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string, opt *ListOptions) ([]User, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/stargazers", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := new([]User)
+	resp, err := s.client.Do(ctx, req, users)
+	return *users, resp, err
+}
+
+// ListStargazersWithTimestamps lists the users who have starred the specified
+// repository, with the time each of them starred it.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#alternative-response-with-star-creation-timestamps
+func (s *ActivityService) ListStargazersWithTimestamps(ctx context.Context, owner, repo string, opt *ListOptions) ([]Stargazer, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/stargazers", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeStarredAt)
+
+	stargazers := new([]Stargazer)
+	resp, err := s.client.Do(ctx, req, stargazers)
+	return *stargazers, resp, err
+}
+
 // ActivityListStarredOptions specifies the optional parameters to the
-// ActivityService.ListStarred method.
+// ActivityService.ListStarred and ListStarredWithTimestamps methods.
 type ActivityListStarredOptions struct {
-	// How to sort the repository list.  Possible values are: created, updated,
-	// pushed, full_name.  Default is "full_name".
-	Sort string
+	// How to sort the repository list.  Possible values are: created (when
+	// the repository was starred) and updated (when it was last pushed to).
+	// Default is "created".
+	Sort string `url:"sort,omitempty"`
 
-	// Direction in which to sort repositories.  Possible values are: asc, desc.
-	// Default is "asc" when sort is "full_name", otherwise default is "desc".
-	Direction string
+	// Direction in which to sort repositories.  Possible values are: asc,
+	// desc.  Default is "desc".
+	Direction string `url:"direction,omitempty"`
 
-	// For paginated result sets, page of results to retrieve.
-	Page int
+	ListOptions
 }
 
 // ListStarred lists all the repos starred by a user.  Passing the empty string
 // will list the starred repositories for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/activity/starring/#list-repositories-being-starred
-func (s *ActivityService) ListStarred(user string, opt *ActivityListStarredOptions) ([]Repository, *Response, error) {
+func (s *ActivityService) ListStarred(ctx context.Context, user string, opt *ActivityListStarredOptions) ([]Repository, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/starred", user)
 	} else {
 		u = "user/starred"
 	}
-	if opt != nil {
-		params := url.Values{
-			"sort":      []string{opt.Sort},
-			"direction": []string{opt.Direction},
-			"page":      []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -51,6 +113,70 @@ func (s *ActivityService) ListStarred(user string, opt *ActivityListStarredOptio
 	}
 
 	repos := new([]Repository)
-	resp, err := s.client.Do(req, repos)
+	resp, err := s.client.Do(ctx, req, repos)
 	return *repos, resp, err
 }
+
+// ListStarredWithTimestamps lists the repos starred by a user like
+// ListStarred, with the time each of them was starred.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#alternative-response-with-star-creation-timestamps-1
+func (s *ActivityService) ListStarredWithTimestamps(ctx context.Context, user string, opt *ActivityListStarredOptions) ([]StarredRepository, *Response, error) {
+	var u string
+	if user != "" {
+		u = fmt.Sprintf("users/%v/starred", user)
+	} else {
+		u = "user/starred"
+	}
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeStarredAt)
+
+	repos := new([]StarredRepository)
+	resp, err := s.client.Do(ctx, req, repos)
+	return *repos, resp, err
+}
+
+// IsStarred checks if a repository is starred by the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#check-if-you-are-starring-a-repository
+func (s *ActivityService) IsStarred(ctx context.Context, owner, repo string) (bool, *Response, error) {
+	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+	resp, err := s.client.Do(ctx, req, nil)
+	starred, err := parseBoolResponse(err)
+	return starred, resp, err
+}
+
+// Star a repository as the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#star-a-repository
+func (s *ActivityService) Star(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Unstar a repository as the authenticated user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/starring/#unstar-a-repository
+func (s *ActivityService) Unstar(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -6,10 +6,12 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestActivityService_ListStarred_authenticatedUser(t *testing.T) {
@@ -21,7 +23,7 @@ func TestActivityService_ListStarred_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	repos, _, err := client.Activity.ListStarred("", nil)
+	repos, _, err := client.Activity.ListStarred(context.Background(), "", nil)
 	if err != nil {
 		t.Errorf("Activity.ListStarred returned error: %v", err)
 	}
@@ -46,8 +48,8 @@ func TestActivityService_ListStarred_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":2}]`)
 	})
 
-	opt := &ActivityListStarredOptions{"created", "asc", 2}
-	repos, _, err := client.Activity.ListStarred("u", opt)
+	opt := &ActivityListStarredOptions{"created", "asc", ListOptions{Page: 2}}
+	repos, _, err := client.Activity.ListStarred(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Activity.ListStarred returned error: %v", err)
 	}
@@ -57,3 +59,140 @@ func TestActivityService_ListStarred_specifiedUser(t *testing.T) {
 		t.Errorf("Activity.ListStarred returned %+v, want %+v", repos, want)
 	}
 }
+
+func TestActivityService_ListStarredWithTimestamps(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mimeStarredAt)
+		testFormValues(t, r, values{"sort": "updated", "direction": "asc", "per_page": "50"})
+		fmt.Fprint(w, `[{"starred_at":"2006-01-02T15:04:05Z","repo":{"id":1}}]`)
+	})
+
+	opt := &ActivityListStarredOptions{Sort: "updated", Direction: "asc", ListOptions: ListOptions{PerPage: 50}}
+	repos, _, err := client.Activity.ListStarredWithTimestamps(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Activity.ListStarredWithTimestamps returned error: %v", err)
+	}
+
+	want := []StarredRepository{{
+		StarredAt:  &Timestamp{time.Date(2006, 01, 02, 15, 04, 05, 0, time.UTC)},
+		Repository: &Repository{ID: Int(1)},
+	}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Activity.ListStarredWithTimestamps returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestActivityService_ListStargazers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	stargazers, _, err := client.Activity.ListStargazers(context.Background(), "o", "r", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Activity.ListStargazers returned error: %v", err)
+	}
+
+	want := []User{{ID: Int(1)}}
+	if !reflect.DeepEqual(stargazers, want) {
+		t.Errorf("Activity.ListStargazers returned %+v, want %+v", stargazers, want)
+	}
+}
+
+func TestActivityService_ListStargazersWithTimestamps(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mimeStarredAt)
+		fmt.Fprint(w, `[{"starred_at":"2006-01-02T15:04:05Z","user":{"id":1}}]`)
+	})
+
+	stargazers, _, err := client.Activity.ListStargazersWithTimestamps(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Activity.ListStargazersWithTimestamps returned error: %v", err)
+	}
+
+	want := []Stargazer{{
+		StarredAt: &Timestamp{time.Date(2006, 01, 02, 15, 04, 05, 0, time.UTC)},
+		User:      &User{ID: Int(1)},
+	}}
+	if !reflect.DeepEqual(stargazers, want) {
+		t.Errorf("Activity.ListStargazersWithTimestamps returned %+v, want %+v", stargazers, want)
+	}
+}
+
+func TestActivityService_IsStarred_hasStar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	star, _, err := client.Activity.IsStarred(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Activity.IsStarred returned error: %v", err)
+	}
+	if want := true; star != want {
+		t.Errorf("Activity.IsStarred returned %+v, want %+v", star, want)
+	}
+}
+
+func TestActivityService_IsStarred_noStar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	star, _, err := client.Activity.IsStarred(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Activity.IsStarred returned error: %v", err)
+	}
+	if want := false; star != want {
+		t.Errorf("Activity.IsStarred returned %+v, want %+v", star, want)
+	}
+}
+
+func TestActivityService_Star(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Activity.Star(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Activity.Star returned error: %v", err)
+	}
+}
+
+func TestActivityService_Unstar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Activity.Unstar(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Activity.Unstar returned error: %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+)
+
+// A Cache stores the responses to GET requests so that they can be
+// revalidated with conditional requests.  GitHub does not count a 304 Not
+// Modified response against the rate limit, so a cache saves requests when
+// polling resources that rarely change.
+//
+// Implementations must be safe for concurrent use.  Errors are not reported:
+// a failed Set or Delete simply means the next request is unconditional.
+//
+// GitHub API docs: http://developer.github.com/v3/#conditional-requests
+type Cache interface {
+	// Get returns the data stored under key, and whether it was found.
+	Get(key string) ([]byte, bool)
+
+	// Set stores data under key, replacing any previous value.
+	Set(key string, data []byte)
+
+	// Delete removes the value stored under key, if any.
+	Delete(key string)
+}
+
+// MemoryCache is a Cache that keeps responses in memory.  The zero value is an
+// empty cache ready to use.
+type MemoryCache struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+// Get implements the Cache interface.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.items[key]
+	return data, ok
+}
+
+// Set implements the Cache interface.
+func (c *MemoryCache) Set(key string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items == nil {
+		c.items = make(map[string][]byte)
+	}
+	c.items[key] = data
+}
+
+// Delete implements the Cache interface.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// DiskCache is a Cache that stores each response in its own file in Dir, so
+// that cached responses survive restarts.  Dir is created if needed.  As for
+// any Cache, clients whose credentials cannot be told apart by Client.Cache
+// must not share a DiskCache.
+type DiskCache struct {
+	// Dir is the directory holding the cache files.
+	Dir string
+}
+
+// path returns the name of the file holding the value for key.
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements the Cache interface.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Set implements the Cache interface.  The data is written to a temporary
+// file which is then renamed, so that concurrent readers never see a partial
+// value.
+func (c *DiskCache) Set(key string, data []byte) {
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return
+	}
+	f, err := ioutil.TempFile(c.Dir, "tmp")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete implements the Cache interface.
+func (c *DiskCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
+
+// cacheEntry is a response stored in a Cache.
+type cacheEntry struct {
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         []byte      `json:"body"`
+}
+
+// cacheKey returns the key under which the response to req is cached.  The
+// Accept header is part of the key since it selects the representation
+// returned for the same URL, and so is a fingerprint of the credentials sent
+// with req, since they select the resources visible in the response.
+func (c *Client) cacheKey(req *http.Request) string {
+	key := req.URL.String() + " " + req.Header.Get("Accept")
+	if cred := c.credentials(req); cred != "" {
+		sum := sha256.Sum256([]byte(cred))
+		key += " " + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+// credentials returns the credentials sent with req, as set in its
+// Authorization header or added by the authenticating transport of c.
+func (c *Client) credentials(req *http.Request) string {
+	cred := req.Header.Get("Authorization")
+	switch t := c.client.Transport.(type) {
+	case *TokenAuthTransport:
+		cred += "\x00token " + t.Token
+	case *BasicAuthTransport:
+		cred += "\x00basic " + t.Username + ":" + t.Password
+	case *UnauthenticatedRateLimitedTransport:
+		cred += "\x00client " + t.ClientID + ":" + t.ClientSecret
+	}
+	return cred
+}
+
+// cacheable reports whether the response to req can be stored in c.Cache.
+func (c *Client) cacheable(req *http.Request) bool {
+	return c.Cache != nil && req.Method == "GET"
+}
+
+// addConditionalHeaders looks up a cached response to req and, if one is
+// found, adds the headers that make req conditional on it.  The cached entry
+// is returned so that it can be used if GitHub answers 304 Not Modified.
+func (c *Client) addConditionalHeaders(req *http.Request) *cacheEntry {
+	data, ok := c.Cache.Get(c.cacheKey(req))
+	if !ok {
+		return nil
+	}
+	entry := new(cacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil
+	}
+
+	// req shares its Header with the request of the caller.
+	req.Header = req.Header.Clone()
+	if entry.ETag != "" {
+		req.Header.Set(headerIfNoneMatch, entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set(headerIfModifiedSince, entry.LastModified)
+	}
+	return entry
+}
+
+// updateCache reconciles resp with the cache.  A 304 Not Modified response to
+// a request made conditional by entry is replaced with the cached response,
+// keeping the fresh headers (such as the rate limit) from the 304.  A
+// successful response carrying validators is stored for later requests.  The
+// returned bool reports whether the response was served from the cache.
+func (c *Client) updateCache(req *http.Request, resp *http.Response, entry *cacheEntry) (*http.Response, bool, error) {
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+
+		header := make(http.Header)
+		for k, v := range entry.Header {
+			header[k] = v
+		}
+		for k, v := range resp.Header {
+			header[k] = v
+		}
+
+		r := new(http.Response)
+		*r = *resp
+		r.Status = "200 OK"
+		r.StatusCode = http.StatusOK
+		r.Header = header
+		r.Body = ioutil.NopCloser(bytes.NewReader(entry.Body))
+		r.ContentLength = int64(len(entry.Body))
+		return r, true, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, false, nil
+	}
+
+	etag, lastModified := resp.Header.Get(headerETag), resp.Header.Get(headerLastModified)
+	if etag == "" && lastModified == "" {
+		c.Cache.Delete(c.cacheKey(req))
+		return resp, false, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, false, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	data, err := json.Marshal(&cacheEntry{
+		ETag:         etag,
+		LastModified: lastModified,
+		Header:       resp.Header,
+		Body:         body,
+	})
+	if err == nil {
+		c.Cache.Set(c.cacheKey(req), data)
+	}
+	return resp, false, nil
+}
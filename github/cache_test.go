@@ -0,0 +1,197 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func testCache(t *testing.T, c Cache) {
+	if _, ok := c.Get("k"); ok {
+		t.Errorf("Get on empty cache returned ok")
+	}
+
+	c.Set("k", []byte("v1"))
+	c.Set("k", []byte("v2"))
+	if got, ok := c.Get("k"); !ok || string(got) != "v2" {
+		t.Errorf("Get returned %q, %v, want %q, true", got, ok, "v2")
+	}
+
+	c.Delete("k")
+	if _, ok := c.Get("k"); ok {
+		t.Errorf("Get after Delete returned ok")
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	testCache(t, new(MemoryCache))
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-github-cache")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCache(t, &DiskCache{Dir: dir + "/sub"})
+}
+
+func TestDo_cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(headerRateRemaining, fmt.Sprint(60-requests))
+		if r.Header.Get(headerIfNoneMatch) == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headerETag, `"abc"`)
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	client.Cache = new(MemoryCache)
+	want := []Event{{ID: String("1")}}
+
+	events, resp, err := client.Activity.ListRepositoryEvents(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Fatalf("ListRepositoryEvents returned error: %v", err)
+	}
+	if resp.FromCache {
+		t.Errorf("First response was served from the cache")
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("ListRepositoryEvents returned %+v, want %+v", events, want)
+	}
+
+	events, resp, err = client.Activity.ListRepositoryEvents(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Fatalf("ListRepositoryEvents returned error: %v", err)
+	}
+	if !resp.FromCache {
+		t.Errorf("Second response was not served from the cache")
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("ListRepositoryEvents returned %+v, want %+v", events, want)
+	}
+	if got, want := resp.Header.Get(headerETag), `"abc"`; got != want {
+		t.Errorf("Cached response ETag = %v, want %v", got, want)
+	}
+	if got, want := client.Rates().Core.Remaining, 58; got != want {
+		t.Errorf("Client rate remaining = %v, want %v", got, want)
+	}
+}
+
+func TestDo_cacheLastModified(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const lastModified = "Mon, 01 Jul 2013 17:47:53 GMT"
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerIfModifiedSince) == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headerLastModified, lastModified)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	client.Cache = new(MemoryCache)
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("GET", "/", nil)
+		body := new(struct{ A string })
+		resp, err := client.Do(context.Background(), req, body)
+		if err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+		if want := i == 1; resp.FromCache != want {
+			t.Errorf("Request %d: FromCache = %v, want %v", i, resp.FromCache, want)
+		}
+		if body.A != "a" {
+			t.Errorf("Request %d: body = %+v, want A: a", i, body)
+		}
+	}
+}
+
+func TestDo_cacheKeepsRequestHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerETag, `"abc"`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	client.Cache = new(MemoryCache)
+	req, _ := client.NewRequest("GET", "/", nil)
+	for i := 0; i < 2; i++ {
+		if _, err := client.Do(context.Background(), req, nil); err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+	}
+	if v := req.Header.Get(headerIfNoneMatch); v != "" {
+		t.Errorf("Do set %v = %q on the caller's request", headerIfNoneMatch, v)
+	}
+}
+
+func TestDo_cachePerCredentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get(headerIfNoneMatch); v != "" {
+			t.Errorf("Request with %v has If-None-Match %v from another user", r.Header.Get("Authorization"), v)
+		}
+		w.Header().Set(headerETag, `"abc"`)
+		fmt.Fprintf(w, `{"login":%q}`, r.Header.Get("Authorization"))
+	})
+
+	cache := new(MemoryCache)
+	for _, token := range []string{"a", "b"} {
+		tp := &TokenAuthTransport{Token: token}
+		c := NewClient(tp.Client())
+		c.BaseURL = client.BaseURL
+		c.Cache = cache
+
+		user, resp, err := c.Users.Get(context.Background(), "")
+		if err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+		if resp.FromCache {
+			t.Errorf("Response for token %v was served from the cache", token)
+		}
+		if want := "token " + token; *user.Login != want {
+			t.Errorf("Users.Get returned %v, want %v", *user.Login, want)
+		}
+	}
+}
+
+func TestDo_cacheSkipsNonGET(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get(headerIfNoneMatch); v != "" {
+			t.Errorf("%v request sent If-None-Match %v", r.Method, v)
+		}
+		w.Header().Set(headerETag, `"abc"`)
+	})
+
+	client.Cache = new(MemoryCache)
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("POST", "/", nil)
+		client.Do(context.Background(), req, nil)
+	}
+}
@@ -12,13 +12,44 @@ Client:
 	client := github.NewClient(nil)
 
 	// list all organizations for user "willnorris"
-	orgs, _, err := client.Organizations.List("willnorris", nil)
+	orgs, _, err := client.Organizations.List(ctx, "willnorris", nil)
+
+Every API method takes a context.Context as its first argument.  Canceling
+the context, or letting its deadline pass, aborts the request in flight and
+causes the method to return the context's error:
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	repos, _, err := client.Repositories.ListAll(ctx, nil)
 
 Set optional parameters for an API method by passing an Options object.
 
 	// list recently updated repositories for org "github"
 	opt := &github.RepositoryListByOrgOptions{Sort: "updated"}
-	repos, _, err := client.Repositories.ListByOrg("github", opt)
+	repos, _, err := client.Repositories.ListByOrg(ctx, "github", opt)
+
+List methods that support pagination accept a ListOptions, either directly or
+embedded in their options struct.  Set PerPage to fetch up to 100 results per
+request, and use Client.Paginate to walk every page of a result set:
+
+	opt := &github.RepositoryListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	list := func(ctx context.Context, page *github.ListOptions) (interface{}, *github.Response, error) {
+		opt.ListOptions = *page
+		return client.Repositories.List(ctx, "willnorris", opt)
+	}
+	var repos []github.Repository
+	err := client.PaginateAll(ctx, &github.PaginateOptions{ListOptions: opt.ListOptions}, list, &repos)
+
+Request the bodies of issues, pull requests and comments rendered as HTML or
+plain text by calling a method with a context from WithBodyFormat.  Diffs and
+patches of pull requests and commits are returned by PullRequests.GetRaw and
+Repositories.GetCommitRaw:
+
+	ctx := github.WithBodyFormat(ctx, github.BodyHTML)
+	issue, _, err := client.Issues.Get(ctx, "o", "r", 1) // sets issue.BodyHTML
+
+	diff, _, err := client.PullRequests.GetRaw(ctx, "o", "r", 1, github.Diff)
 
 Make authenticated API calls by constructing a GitHub client using an OAuth
 capable http.Client:
@@ -34,12 +65,33 @@ capable http.Client:
 	client := github.NewClient(t.Client())
 
 	// list all repositories for the authenticated user
-	repos, _, err := client.Repositories.List(nil)
+	repos, _, err := client.Repositories.List(ctx, "", nil)
 
 Note that when using an authenticated Client, all calls made by the client will
 include the specified OAuth token. Therefore, authenticated clients should
 almost never be shared between different users.
 
+Observe the requests made by a Client by adding Middleware to it.  The
+library provides middleware for logging requests, with credentials redacted,
+and for collecting Prometheus-style metrics:
+
+	counters := new(github.Counters)
+	client.Middleware = []github.Middleware{
+		github.LoggingMiddleware(log.Printf),
+		github.MetricsMiddleware(counters),
+	}
+	http.Handle("/metrics", counters)
+
+Receive webhook deliveries with a WebHookHandler, which checks their
+signatures and passes typed events to the callbacks registered for them:
+
+	h := &github.WebHookHandler{Secret: "s3cr3t"}
+	h.OnPush(func(d *github.WebHookDelivery, e *github.WebHookPayload) error {
+		log.Printf("push to %v", *e.Ref)
+		return nil
+	})
+	http.Handle("/hooks", h)
+
 The full GitHub API is documented at http://developer.github.com/v3/.
 */
 package github
@@ -0,0 +1,215 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+	headerOTP        = "X-GitHub-OTP"
+)
+
+// Sentinel errors matched by the typed errors returned from CheckResponse, for
+// use with errors.Is:
+//
+//	if errors.Is(err, github.ErrNotFound) {
+//		// the resource does not exist
+//	}
+//
+// Use errors.As with the corresponding error type to inspect the details.
+var (
+	ErrNotFound       = errors.New("github: not found")
+	ErrUnauthorized   = errors.New("github: unauthorized")
+	ErrTwoFactorAuth  = errors.New("github: two-factor authentication code required")
+	ErrValidation     = errors.New("github: validation failed")
+	ErrConflict       = errors.New("github: conflict")
+	ErrAbuseRateLimit = errors.New("github: abuse detection mechanism triggered")
+	ErrServer         = errors.New("github: server error")
+	ErrRateLimit      = errors.New("github: API rate limit exceeded")
+)
+
+// NotFoundError occurs when GitHub returns a 404 Not Found response.  GitHub
+// also uses 404 instead of 403 for private resources the client cannot see.
+type NotFoundError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *NotFoundError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// UnauthorizedError occurs when GitHub returns a 401 Unauthorized response,
+// usually because the credentials are missing or invalid.
+type UnauthorizedError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *UnauthorizedError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrUnauthorized.
+func (e *UnauthorizedError) Is(target error) bool { return target == ErrUnauthorized }
+
+// TwoFactorAuthError occurs when GitHub returns a 401 Unauthorized response
+// with an "X-GitHub-OTP: required" header, because the account has two-factor
+// authentication enabled and the request did not include a valid one-time
+// password.  The request can be retried after setting BasicAuthTransport.OTP.
+//
+// GitHub API docs: http://developer.github.com/v3/auth/#working-with-two-factor-authentication
+type TwoFactorAuthError struct {
+	*ErrorResponse
+
+	// Method is how the user receives one-time passwords, as given by the
+	// X-GitHub-OTP header: "app" or "sms".  GitHub sends the code by SMS in
+	// response to the failed request when Method is "sms".
+	Method string
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *TwoFactorAuthError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrTwoFactorAuth or ErrUnauthorized.
+func (e *TwoFactorAuthError) Is(target error) bool {
+	return target == ErrTwoFactorAuth || target == ErrUnauthorized
+}
+
+// ValidationError occurs when GitHub returns a 422 Unprocessable Entity
+// response.  The Errors field of the embedded ErrorResponse describes which
+// fields of which resources failed validation.
+type ValidationError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *ValidationError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrValidation.
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// ConflictError occurs when GitHub returns a 409 Conflict response.
+type ConflictError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *ConflictError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrConflict.
+func (e *ConflictError) Is(target error) bool { return target == ErrConflict }
+
+// AbuseRateLimitError occurs when GitHub returns a 403 Forbidden response
+// because its abuse detection mechanism was triggered, for example by making
+// many requests concurrently.
+//
+// GitHub API docs: http://developer.github.com/v3/#abuse-rate-limits
+type AbuseRateLimitError struct {
+	*ErrorResponse
+
+	// RetryAfter is how long GitHub asked the client to wait before retrying,
+	// as given by the Retry-After header.  It is nil if the header was not
+	// sent.
+	RetryAfter *time.Duration
+}
+
+func (e *AbuseRateLimitError) Error() string {
+	if e.RetryAfter == nil {
+		return e.ErrorResponse.Error()
+	}
+	return fmt.Sprintf("%v; retry after %v", e.ErrorResponse.Error(), *e.RetryAfter)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *AbuseRateLimitError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrAbuseRateLimit.
+func (e *AbuseRateLimitError) Is(target error) bool { return target == ErrAbuseRateLimit }
+
+// ServerError occurs when GitHub returns a 5xx response.
+type ServerError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *ServerError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrServer.
+func (e *ServerError) Is(target error) bool { return target == ErrServer }
+
+// typedError returns the error type matching the status code of the response
+// that caused e, or e itself if there is none.
+func typedError(e *ErrorResponse) error {
+	r := e.Response
+	switch c := r.StatusCode; {
+	case c == http.StatusUnauthorized:
+		if method, ok := parseOTPRequired(r.Header); ok {
+			return &TwoFactorAuthError{ErrorResponse: e, Method: method}
+		}
+		return &UnauthorizedError{e}
+	case c == http.StatusForbidden && isAbuseRateLimit(e):
+		return &AbuseRateLimitError{ErrorResponse: e, RetryAfter: parseRetryAfter(r.Header)}
+	case c == http.StatusNotFound:
+		return &NotFoundError{e}
+	case c == http.StatusConflict:
+		return &ConflictError{e}
+	case c == 422: // Unprocessable Entity
+		return &ValidationError{e}
+	case c >= 500:
+		return &ServerError{e}
+	}
+	return e
+}
+
+// parseOTPRequired reports whether the X-GitHub-OTP header in h asks for a
+// one-time password, which it does in the form "required; app".  The delivery
+// method is returned if given.
+func parseOTPRequired(h http.Header) (method string, ok bool) {
+	parts := strings.SplitN(h.Get(headerOTP), ";", 2)
+	if strings.TrimSpace(parts[0]) != "required" {
+		return "", false
+	}
+	if len(parts) == 2 {
+		method = strings.TrimSpace(parts[1])
+	}
+	return method, true
+}
+
+// isAbuseRateLimit reports whether the 403 Forbidden response that caused e
+// was sent by GitHub's abuse detection mechanism.
+func isAbuseRateLimit(e *ErrorResponse) bool {
+	return e.Response.Header.Get(headerRetryAfter) != "" ||
+		strings.Contains(e.DocumentationURL, "abuse") ||
+		strings.Contains(strings.ToLower(e.Message), "abuse")
+}
+
+// parseRetryAfter returns the delay given by the Retry-After header in h, in
+// either its delay-seconds or its HTTP-date form, or nil if there is none.
+func parseRetryAfter(h http.Header) *time.Duration {
+	v := h.Get(headerRetryAfter)
+	if v == "" {
+		return nil
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		d := time.Duration(secs) * time.Second
+		return &d
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(time.Now())
+		if d < 0 {
+			d = 0
+		}
+		return &d
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newErrorResponse(code int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Request:    &http.Request{},
+		StatusCode: code,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestCheckResponse_typedErrors(t *testing.T) {
+	tests := []struct {
+		code     int
+		header   http.Header
+		body     string
+		sentinel error
+		want     interface{}
+	}{
+		{http.StatusUnauthorized, nil, `{"message":"Bad credentials"}`, ErrUnauthorized, new(*UnauthorizedError)},
+		{http.StatusUnauthorized, http.Header{"X-Github-Otp": {"required; app"}}, `{"message":"Must specify two-factor authentication OTP code."}`, ErrTwoFactorAuth, new(*TwoFactorAuthError)},
+		{http.StatusUnauthorized, http.Header{"X-Github-Otp": {"required; sms"}}, `{}`, ErrUnauthorized, new(*TwoFactorAuthError)},
+		{http.StatusNotFound, nil, `{"message":"Not Found"}`, ErrNotFound, new(*NotFoundError)},
+		{http.StatusConflict, nil, `{"message":"Merge conflict"}`, ErrConflict, new(*ConflictError)},
+		{422, nil, `{"message":"Validation Failed"}`, ErrValidation, new(*ValidationError)},
+		{http.StatusBadGateway, nil, ``, ErrServer, new(*ServerError)},
+		{http.StatusServiceUnavailable, nil, ``, ErrServer, new(*ServerError)},
+		{http.StatusForbidden, nil, `{"message":"You have triggered an abuse detection mechanism."}`, ErrAbuseRateLimit, new(*AbuseRateLimitError)},
+		{http.StatusForbidden, http.Header{"Retry-After": {"30"}}, `{}`, ErrAbuseRateLimit, new(*AbuseRateLimitError)},
+	}
+
+	for _, tt := range tests {
+		err := CheckResponse(newErrorResponse(tt.code, tt.header, tt.body))
+
+		if !errors.Is(err, tt.sentinel) {
+			t.Errorf("CheckResponse(%d) = %#v, want error matching %v", tt.code, err, tt.sentinel)
+		}
+		if !errors.As(err, tt.want) {
+			t.Errorf("CheckResponse(%d) = %#v, want %T", tt.code, err, reflect.ValueOf(tt.want).Elem().Interface())
+		}
+
+		var errorResponse *ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.Response.StatusCode != tt.code {
+			t.Errorf("CheckResponse(%d) = %#v, does not wrap the *ErrorResponse", tt.code, err)
+		}
+		if err.Error() == "" {
+			t.Errorf("Expected non-empty Error() for status %d", tt.code)
+		}
+	}
+}
+
+func TestCheckResponse_plainForbidden(t *testing.T) {
+	err := CheckResponse(newErrorResponse(http.StatusForbidden, nil, `{"message":"Must have admin rights"}`))
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("CheckResponse = %#v, want *ErrorResponse", err)
+	}
+}
+
+func TestCheckResponse_validationErrors(t *testing.T) {
+	err := CheckResponse(newErrorResponse(422, nil, `{"message":"Validation Failed",
+		"errors": [{"resource": "Issue", "field": "title", "code": "missing_field"}]}`))
+
+	var v *ValidationError
+	if !errors.As(err, &v) {
+		t.Fatalf("CheckResponse = %#v, want *ValidationError", err)
+	}
+	want := []Error{{Resource: "Issue", Field: "title", Code: "missing_field"}}
+	if !reflect.DeepEqual(v.Errors, want) {
+		t.Errorf("ValidationError.Errors = %+v, want %+v", v.Errors, want)
+	}
+}
+
+func TestCheckResponse_abuseRetryAfter(t *testing.T) {
+	err := CheckResponse(newErrorResponse(http.StatusForbidden, http.Header{"Retry-After": {"30"}},
+		`{"message":"m","documentation_url":"https://developer.github.com/v3/#abuse-rate-limits"}`))
+
+	var abuse *AbuseRateLimitError
+	if !errors.As(err, &abuse) {
+		t.Fatalf("CheckResponse = %#v, want *AbuseRateLimitError", err)
+	}
+	if abuse.RetryAfter == nil || *abuse.RetryAfter != 30*time.Second {
+		t.Errorf("AbuseRateLimitError.RetryAfter = %v, want 30s", abuse.RetryAfter)
+	}
+	if want := "https://developer.github.com/v3/#abuse-rate-limits"; abuse.DocumentationURL != want {
+		t.Errorf("AbuseRateLimitError.DocumentationURL = %v, want %v", abuse.DocumentationURL, want)
+	}
+}
+
+func TestCheckResponse_rateLimitIs(t *testing.T) {
+	h := make(http.Header)
+	h.Set(headerRateRemaining, "0")
+	err := CheckResponse(newErrorResponse(http.StatusForbidden, h, `{}`))
+	if !errors.Is(err, ErrRateLimit) {
+		t.Errorf("CheckResponse = %#v, want error matching ErrRateLimit", err)
+	}
+	if errors.Is(err, ErrAbuseRateLimit) {
+		t.Errorf("CheckResponse = %#v, unexpectedly matches ErrAbuseRateLimit", err)
+	}
+}
+
+func TestCheckResponse_twoFactorAuth(t *testing.T) {
+	header := make(http.Header)
+	header.Set("X-GitHub-OTP", "required; sms")
+	err := CheckResponse(newErrorResponse(http.StatusUnauthorized, header, `{}`))
+
+	var tfa *TwoFactorAuthError
+	if !errors.As(err, &tfa) {
+		t.Fatalf("CheckResponse returned %#v, want *TwoFactorAuthError", err)
+	}
+	if want := "sms"; tfa.Method != want {
+		t.Errorf("TwoFactorAuthError.Method = %v, want %v", tfa.Method, want)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d := parseRetryAfter(http.Header{}); d != nil {
+		t.Errorf("parseRetryAfter with no header = %v, want nil", *d)
+	}
+	if d := parseRetryAfter(http.Header{"Retry-After": {"bogus"}}); d != nil {
+		t.Errorf("parseRetryAfter(bogus) = %v, want nil", *d)
+	}
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	d := parseRetryAfter(http.Header{"Retry-After": {date}})
+	if d == nil || *d <= 58*time.Minute || *d > time.Hour {
+		t.Errorf("parseRetryAfter(%v) = %v, want about 1h", date, d)
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "time"
+
+// These types are the payloads of events, as returned by Event.ParsePayload
+// and delivered to webhooks.  Fields describing the repository and the user
+// that triggered the event are only set in webhook deliveries; for timeline
+// events they are in the Event itself.
+
+// PullRequestEvent is triggered when a pull request is opened, closed,
+// reopened or synchronized.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#pullrequestevent
+type PullRequestEvent struct {
+	// Action is the action that was performed.  Possible values are: opened,
+	// closed, reopened, synchronize.
+	Action      *string      `json:"action,omitempty"`
+	Number      *int         `json:"number,omitempty"`
+	PullRequest *PullRequest `json:"pull_request,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e PullRequestEvent) String() string {
+	return Stringify(e)
+}
+
+// IssuesEvent is triggered when an issue is opened, closed or reopened.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#issuesevent
+type IssuesEvent struct {
+	// Action is the action that was performed.  Possible values are: opened,
+	// closed, reopened.
+	Action *string `json:"action,omitempty"`
+	Issue  *Issue  `json:"issue,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e IssuesEvent) String() string {
+	return Stringify(e)
+}
+
+// IssueCommentEvent is triggered when a comment is left on an issue or pull
+// request.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#issuecommentevent
+type IssueCommentEvent struct {
+	// Action is the action that was performed on the comment.  Possible value
+	// is: created.
+	Action  *string       `json:"action,omitempty"`
+	Issue   *Issue        `json:"issue,omitempty"`
+	Comment *IssueComment `json:"comment,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e IssueCommentEvent) String() string {
+	return Stringify(e)
+}
+
+// StatusEvent is triggered when the status of a commit changes.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#statusevent
+type StatusEvent struct {
+	ID  *int    `json:"id,omitempty"`
+	SHA *string `json:"sha,omitempty"`
+
+	// Name is the full name of the repository, such as "octocat/Hello-World".
+	Name *string `json:"name,omitempty"`
+
+	// State is the new state.  Possible values are: pending, success, error,
+	// failure.
+	State       *string    `json:"state,omitempty"`
+	TargetURL   *string    `json:"target_url,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e StatusEvent) String() string {
+	return Stringify(e)
+}
+
+// CreateEvent is triggered when a repository, branch or tag is created.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#createevent
+type CreateEvent struct {
+	// Ref is the name of the branch or tag created, or nil for a repository.
+	Ref *string `json:"ref,omitempty"`
+
+	// RefType is the type of object created.  Possible values are:
+	// repository, branch, tag.
+	RefType      *string `json:"ref_type,omitempty"`
+	MasterBranch *string `json:"master_branch,omitempty"`
+	Description  *string `json:"description,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e CreateEvent) String() string {
+	return Stringify(e)
+}
+
+// DeleteEvent is triggered when a branch or tag is deleted.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#deleteevent
+type DeleteEvent struct {
+	Ref *string `json:"ref,omitempty"`
+
+	// RefType is the type of object deleted.  Possible values are: branch,
+	// tag.
+	RefType *string `json:"ref_type,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e DeleteEvent) String() string {
+	return Stringify(e)
+}
+
+// PingEvent is delivered when a hook is created, to check that it is
+// configured correctly.
+//
+// GitHub API docs: http://developer.github.com/webhooks/#ping-event
+type PingEvent struct {
+	// Zen is a random string of GitHub zen.
+	Zen    *string `json:"zen,omitempty"`
+	HookID *int    `json:"hook_id,omitempty"`
+	Hook   *Hook   `json:"hook,omitempty"`
+}
+
+func (e PingEvent) String() string {
+	return Stringify(e)
+}
+
+// CommitCommentEvent is triggered when a commit comment is created.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#commitcommentevent
+type CommitCommentEvent struct {
+	Comment *RepositoryComment `json:"comment,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e CommitCommentEvent) String() string {
+	return Stringify(e)
+}
+
+// FollowEvent is triggered when a user follows another user.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#followevent
+type FollowEvent struct {
+	// Target is the user that was followed.
+	Target *User `json:"target,omitempty"`
+
+	Sender *User `json:"sender,omitempty"`
+}
+
+func (e FollowEvent) String() string {
+	return Stringify(e)
+}
+
+// ForkEvent is triggered when a user forks a repository.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#forkevent
+type ForkEvent struct {
+	// Forkee is the created repository.
+	Forkee *Repository `json:"forkee,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e ForkEvent) String() string {
+	return Stringify(e)
+}
+
+// ForkApplyEvent is triggered when a patch is applied in the Fork Queue.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#forkapplyevent
+type ForkApplyEvent struct {
+	// Head is the branch name the patch is applied to.
+	Head   *string `json:"head,omitempty"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+func (e ForkApplyEvent) String() string {
+	return Stringify(e)
+}
+
+// GistEvent is triggered when a gist is created or updated.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#gistevent
+type GistEvent struct {
+	// Action is the action that was performed.  Possible values are: create,
+	// update.
+	Action *string `json:"action,omitempty"`
+	Gist   *Gist   `json:"gist,omitempty"`
+}
+
+func (e GistEvent) String() string {
+	return Stringify(e)
+}
+
+// GollumEvent is triggered when a wiki page is created or updated.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#gollumevent
+type GollumEvent struct {
+	Pages []Page `json:"pages,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e GollumEvent) String() string {
+	return Stringify(e)
+}
+
+// Page represents a single wiki page changed in a GollumEvent.
+type Page struct {
+	PageName *string `json:"page_name,omitempty"`
+	Title    *string `json:"title,omitempty"`
+	Summary  *string `json:"summary,omitempty"`
+
+	// Action is the action that was performed on the page.  Possible values
+	// are: created, edited.
+	Action  *string `json:"action,omitempty"`
+	SHA     *string `json:"sha,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+func (p Page) String() string {
+	return Stringify(p)
+}
+
+// MemberEvent is triggered when a user is added as a collaborator to a
+// repository.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#memberevent
+type MemberEvent struct {
+	// Action is the action that was performed.  Possible value is: added.
+	Action *string `json:"action,omitempty"`
+	Member *User   `json:"member,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e MemberEvent) String() string {
+	return Stringify(e)
+}
+
+// PublicEvent is triggered when a private repository is open sourced.  Its
+// payload is empty apart from the fields set in webhook deliveries.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#publicevent
+type PublicEvent struct {
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e PublicEvent) String() string {
+	return Stringify(e)
+}
+
+// PullRequestReviewCommentEvent is triggered when a comment is created on a
+// portion of the unified diff of a pull request.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#pullrequestreviewcommentevent
+type PullRequestReviewCommentEvent struct {
+	Comment *PullRequestComment `json:"comment,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e PullRequestReviewCommentEvent) String() string {
+	return Stringify(e)
+}
+
+// ReleaseEvent is triggered when a release is published.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#releaseevent
+type ReleaseEvent struct {
+	// Action is the action that was performed.  Possible value is: published.
+	Action  *string            `json:"action,omitempty"`
+	Release *RepositoryRelease `json:"release,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e ReleaseEvent) String() string {
+	return Stringify(e)
+}
+
+// TeamAddEvent is triggered when a repository or user is added to a team.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#teamaddevent
+type TeamAddEvent struct {
+	Team *Team `json:"team,omitempty"`
+
+	// User is the user added to the team, or nil if a repository was added.
+	User *User `json:"user,omitempty"`
+
+	// Repo is the repository added to the team, or nil if a user was added.
+	Repo *Repository `json:"repository,omitempty"`
+
+	Org    *Organization `json:"organization,omitempty"`
+	Sender *User         `json:"sender,omitempty"`
+}
+
+func (e TeamAddEvent) String() string {
+	return Stringify(e)
+}
+
+// WatchEvent is triggered when a user stars a repository.
+//
+// GitHub API docs: http://developer.github.com/v3/activity/events/types/#watchevent
+type WatchEvent struct {
+	// Action is the action that was performed.  Possible value is: started.
+	Action *string `json:"action,omitempty"`
+
+	Repo   *Repository `json:"repository,omitempty"`
+	Sender *User       `json:"sender,omitempty"`
+}
+
+func (e WatchEvent) String() string {
+	return Stringify(e)
+}
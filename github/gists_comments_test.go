@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestGistsService_ListComments(t *testing.T) {
 
 	mux.HandleFunc("/gists/1/comments", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id": 1}]`)
 	})
 
-	comments, _, err := client.Gists.ListComments("1")
+	opt := &ListOptions{Page: 2}
+	comments, _, err := client.Gists.ListComments(context.Background(), "1", opt)
 
 	if err != nil {
 		t.Errorf("Gists.Comments returned error: %v", err)
@@ -43,7 +46,7 @@ func TestGistsService_GetComment(t *testing.T) {
 		fmt.Fprint(w, `{"id": 1}`)
 	})
 
-	comment, _, err := client.Gists.GetComment("1", 2)
+	comment, _, err := client.Gists.GetComment(context.Background(), "1", 2)
 
 	if err != nil {
 		t.Errorf("Gists.GetComment returned error: %v", err)
@@ -73,7 +76,7 @@ func TestGistsService_CreateComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Gists.CreateComment("1", input)
+	comment, _, err := client.Gists.CreateComment(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("Gists.CreateComment returned error: %v", err)
 	}
@@ -102,7 +105,7 @@ func TestGistsService_EditComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Gists.EditComment("1", 2, input)
+	comment, _, err := client.Gists.EditComment(context.Background(), "1", 2, input)
 	if err != nil {
 		t.Errorf("Gists.EditComment returned error: %v", err)
 	}
@@ -121,7 +124,7 @@ func TestGistsService_DeleteComment(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Gists.DeleteComment("1", 2)
+	_, err := client.Gists.DeleteComment(context.Background(), "1", 2)
 	if err != nil {
 		t.Errorf("Gists.Delete returned error: %v", err)
 	}
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,7 +30,7 @@ func TestGistsService_List(t *testing.T) {
 	})
 
 	opt := &GistListOptions{Since: time.Date(2013, time.January, 1, 0, 0, 0, 0, time.UTC)}
-	gists, _, err := client.Gists.List("u", opt)
+	gists, _, err := client.Gists.List(context.Background(), "u", opt)
 
 	if err != nil {
 		t.Errorf("Gists.List returned error: %v", err)
@@ -50,7 +51,7 @@ func TestGistsService_List_withEmptyUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id": "1"}]`)
 	})
 
-	gists, _, err := client.Gists.List("", nil)
+	gists, _, err := client.Gists.List(context.Background(), "", nil)
 	if err != nil {
 		t.Errorf("Gists.List returned error: %v", err)
 	}
@@ -76,7 +77,7 @@ func TestGistsService_ListAll(t *testing.T) {
 	})
 
 	opt := &GistListOptions{Since: time.Date(2013, time.January, 1, 0, 0, 0, 0, time.UTC)}
-	gists, _, err := client.Gists.ListAll(opt)
+	gists, _, err := client.Gists.ListAll(context.Background(), opt)
 
 	if err != nil {
 		t.Errorf("Gists.ListAll returned error: %v", err)
@@ -103,7 +104,7 @@ func TestGistsService_ListStarred(t *testing.T) {
 	})
 
 	opt := &GistListOptions{Since: time.Date(2013, time.January, 1, 0, 0, 0, 0, time.UTC)}
-	gists, _, err := client.Gists.ListStarred(opt)
+	gists, _, err := client.Gists.ListStarred(context.Background(), opt)
 
 	if err != nil {
 		t.Errorf("Gists.ListStarred returned error: %v", err)
@@ -124,7 +125,7 @@ func TestGistsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"id": "1"}`)
 	})
 
-	gist, _, err := client.Gists.Get("1")
+	gist, _, err := client.Gists.Get(context.Background(), "1")
 
 	if err != nil {
 		t.Errorf("Gists.Get returned error: %v", err)
@@ -171,7 +172,7 @@ func TestGistsService_Create(t *testing.T) {
 			}`)
 	})
 
-	gist, _, err := client.Gists.Create(input)
+	gist, _, err := client.Gists.Create(context.Background(), input)
 	if err != nil {
 		t.Errorf("Gists.Create returned error: %v", err)
 	}
@@ -226,7 +227,7 @@ func TestGistsService_Edit(t *testing.T) {
 			}`)
 	})
 
-	gist, _, err := client.Gists.Edit("1", input)
+	gist, _, err := client.Gists.Edit(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("Gists.Edit returned error: %v", err)
 	}
@@ -253,7 +254,7 @@ func TestGistsService_Delete(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Gists.Delete("1")
+	_, err := client.Gists.Delete(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Gists.Delete returned error: %v", err)
 	}
@@ -267,7 +268,7 @@ func TestGistsService_Star(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Gists.Star("1")
+	_, err := client.Gists.Star(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Gists.Star returned error: %v", err)
 	}
@@ -281,7 +282,7 @@ func TestGistsService_Unstar(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Gists.Unstar("1")
+	_, err := client.Gists.Unstar(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Gists.Unstar returned error: %v", err)
 	}
@@ -296,7 +297,7 @@ func TestGistsService_IsStarred_hasStar(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	star, _, err := client.Gists.IsStarred("1")
+	star, _, err := client.Gists.IsStarred(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Gists.Starred returned error: %v", err)
 	}
@@ -314,7 +315,7 @@ func TestGistsService_IsStarred_noStar(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	star, _, err := client.Gists.IsStarred("1")
+	star, _, err := client.Gists.IsStarred(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Gists.Starred returned error: %v", err)
 	}
@@ -332,7 +333,7 @@ func TestGistsService_Fork(t *testing.T) {
 		fmt.Fprint(w, `{"id": "2"}`)
 	})
 
-	gist, _, err := client.Gists.Fork("1")
+	gist, _, err := client.Gists.Fork(context.Background(), "1")
 
 	if err != nil {
 		t.Errorf("Gists.Fork returned error: %v", err)
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -39,7 +40,7 @@ func (c CommitAuthor) String() string {
 // GetCommit fetchs the Commit object for a given SHA.
 //
 // GitHub API docs: http://developer.github.com/v3/git/commits/#get-a-commit
-func (s *GitService) GetCommit(owner string, repo string, sha string) (*Commit, *Response, error) {
+func (s *GitService) GetCommit(ctx context.Context, owner string, repo string, sha string) (*Commit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/git/commits/%v", owner, repo, sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -47,7 +48,7 @@ func (s *GitService) GetCommit(owner string, repo string, sha string) (*Commit,
 	}
 
 	c := new(Commit)
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	return c, resp, err
 }
 
@@ -58,7 +59,7 @@ func (s *GitService) GetCommit(owner string, repo string, sha string) (*Commit,
 // the authenticated user’s information and the current date.
 //
 // GitHub API docs: http://developer.github.com/v3/git/commits/#create-a-commit
-func (s *GitService) CreateCommit(owner string, repo string, commit *Commit) (*Commit, *Response, error) {
+func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string, commit *Commit) (*Commit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/git/commits", owner, repo)
 	req, err := s.client.NewRequest("POST", u, commit)
 	if err != nil {
@@ -66,6 +67,6 @@ func (s *GitService) CreateCommit(owner string, repo string, commit *Commit) (*C
 	}
 
 	c := new(Commit)
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	return c, resp, err
 }
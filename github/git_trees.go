@@ -5,7 +5,10 @@
 
 package github
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Tree represents a GitHub tree.
 type Tree struct {
@@ -35,7 +38,7 @@ func (t TreeEntry) String() string {
 // GetTree fetches the Tree object for a given sha hash from a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/git/trees/#get-a-tree
-func (s *GitService) GetTree(owner string, repo string, sha string, recursive bool) (*Tree, *Response, error) {
+func (s *GitService) GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*Tree, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/git/trees/%v", owner, repo, sha)
 	if recursive {
 		u += "?recursive=1"
@@ -47,7 +50,7 @@ func (s *GitService) GetTree(owner string, repo string, sha string, recursive bo
 	}
 
 	t := new(Tree)
-	resp, err := s.client.Do(req, t)
+	resp, err := s.client.Do(ctx, req, t)
 	return t, resp, err
 }
 
@@ -62,7 +65,7 @@ type createTree struct {
 // that tree with the new path contents and write a new tree out.
 //
 // GitHub API docs: http://developer.github.com/v3/git/trees/#create-a-tree
-func (s *GitService) CreateTree(owner string, repo string, baseTree string, entries []TreeEntry) (*Tree, *Response, error) {
+func (s *GitService) CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []TreeEntry) (*Tree, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/git/trees", owner, repo)
 
 	body := &createTree{
@@ -75,6 +78,6 @@ func (s *GitService) CreateTree(owner string, repo string, baseTree string, entr
 	}
 
 	t := new(Tree)
-	resp, err := s.client.Do(req, t)
+	resp, err := s.client.Do(ctx, req, t)
 	return t, resp, err
 }
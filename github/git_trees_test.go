@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,7 +28,7 @@ func TestGitService_GetTree(t *testing.T) {
 			}`)
 	})
 
-	tree, _, err := client.Git.GetTree("o", "r", "s", true)
+	tree, _, err := client.Git.GetTree(context.Background(), "o", "r", "s", true)
 	if err != nil {
 		t.Errorf("Git.GetTree returned error: %v", err)
 	}
@@ -88,7 +89,7 @@ func TestGitService_CreateTree(t *testing.T) {
 		}`)
 	})
 
-	tree, _, err := client.Git.CreateTree("o", "r", "b", input)
+	tree, _, err := client.Git.CreateTree(context.Background(), "o", "r", "b", input)
 	if err != nil {
 		t.Errorf("Git.CreateTree returned error: %v", err)
 	}
@@ -7,47 +7,82 @@ package github
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	libraryVersion = "0.1"
 	defaultBaseURL = "https://api.github.com/"
+	uploadBaseURL  = "https://uploads.github.com/"
 	userAgent      = "go-github/" + libraryVersion
 
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
+	headerPollInterval  = "X-Poll-Interval"
 
 	mimePreview = "application/vnd.github.preview"
 )
 
-// A Client manages communication with the GitHub API.
+// A Client manages communication with the GitHub API.  A Client is safe for
+// concurrent use by multiple goroutines, provided its exported fields are not
+// modified while requests are in flight.
 type Client struct {
 	// HTTP client used to communicate with the API.
 	client *http.Client
 
+	rateMu sync.Mutex
+	rates  Rates // rate limits as of the most recent response in each category
+
 	// Base URL for API requests.  Defaults to the public GitHub API, but can be
 	// set to a domain endpoint to use with GitHub Enterprise.  BaseURL should
-	// always be specified with a trailing slash.
+	// always be specified with a trailing slash.  NewEnterpriseClient sets it
+	// up for a GitHub Enterprise host.
 	BaseURL *url.URL
 
+	// Base URL for uploading files, such as release assets.  Like BaseURL it
+	// must have a trailing slash.
+	UploadURL *url.URL
+
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
-	// Rate specifies the current rate limit for the client as determined by the
-	// most recent API call.  If the client is used in a multi-user application,
-	// this rate may not always be up-to-date.  Call RateLimit() to check the
-	// current rate.
-	Rate Rate
+	// RateLimitPolicy specifies what Do does with a request while Rates shows
+	// the rate limit for its category is exhausted.  The default,
+	// RateLimitIgnore, sends the request anyway.
+	RateLimitPolicy RateLimitPolicy
+
+	// Cache, if non-nil, stores the responses to GET requests.  Later requests
+	// for the same resource are made conditional, and answered from the cache
+	// when GitHub reports that the resource has not been modified.  Responses
+	// are cached per Authorization header and per credentials of the
+	// TokenAuthTransport, BasicAuthTransport or
+	// UnauthenticatedRateLimitedTransport of the HTTP client.  A Cache must
+	// not be shared between clients that authenticate differently in other
+	// ways, such as with another custom transport, or one user could be
+	// served the responses of another.
+	Cache Cache
+
+	// Retry, if non-nil, specifies how requests that failed with a transient
+	// error are retried.  By default requests are sent only once.
+	Retry *RetryPolicy
+
+	// Middleware intercepts every request sent by the client, for example to
+	// log requests or collect metrics.  The first middleware is the outermost
+	// one, seeing requests first and responses last.
+	Middleware []Middleware
 
 	// Services used for talking to different parts of the API
 
@@ -66,20 +101,172 @@ type Client struct {
 // support pagination.
 type ListOptions struct {
 	// For paginated result sets, page of results to retrieve.
-	Page int
+	Page int `url:"page,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	// GitHub allows up to 100, and defaults to 30 if unset.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// addOptions adds the parameters in opt as URL query parameters to s.  opt
+// must be a struct, or a pointer to one, whose fields are annotated with "url"
+// tags; see encodeOptions for the supported tag options.  A nil opt leaves s
+// unchanged.
+func addOptions(s string, opt interface{}) (string, error) {
+	v := reflect.ValueOf(opt)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	if err := encodeOptions(qs, reflect.Indirect(v)); err != nil {
+		return s, err
+	}
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// encodeOptions adds the fields of the struct v to values.  Each field is
+// named by the first element of its "url" tag, and is skipped if that tag is
+// missing or "-".  Embedded structs without a tag, such as ListOptions, have
+// their fields added as if they belonged to v.  The tag options are:
+//
+//	omitempty: skip the field if it holds the zero value
+//	comma:     join slice elements with commas instead of repeating the name
+//
+// Strings, bools, integers, time.Time (formatted as RFC 3339) and slices and
+// pointers of those are supported.
+func encodeOptions(values url.Values, v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("github: options must be a struct, got %v", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+
+		tag := sf.Tag.Get("url")
+		if tag == "" && sf.Anonymous && fv.Kind() == reflect.Struct {
+			if err := encodeOptions(values, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		var omitEmpty, comma bool
+		for _, o := range opts[1:] {
+			switch o {
+			case "omitempty":
+				omitEmpty = true
+			case "comma":
+				comma = true
+			}
+		}
+
+		// A pointer field is only empty when nil, so that explicit zero
+		// values such as Bool(false) are still sent.
+		if omitEmpty && isEmptyOption(fv) {
+			continue
+		}
+		for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+
+		if fv.Kind() == reflect.Slice {
+			var s []string
+			for j := 0; j < fv.Len(); j++ {
+				str, err := formatOption(fv.Index(j))
+				if err != nil {
+					return fmt.Errorf("github: option %q: %v", name, err)
+				}
+				s = append(s, str)
+			}
+			if comma {
+				values.Add(name, strings.Join(s, ","))
+			} else {
+				for _, str := range s {
+					values.Add(name, str)
+				}
+			}
+			continue
+		}
+
+		str, err := formatOption(fv)
+		if err != nil {
+			return fmt.Errorf("github: option %q: %v", name, err)
+		}
+		values.Add(name, str)
+	}
+	return nil
+}
+
+// isEmptyOption reports whether v holds the zero value for its type.
+func isEmptyOption(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.IsZero()
+	}
+	return false
+}
+
+// formatOption returns the string form of a single option value.
+func formatOption(v reflect.Value) (string, error) {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339), nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "", nil
+		}
+		return formatOption(v.Elem())
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported type %v", v.Type())
 }
 
 // NewClient returns a new GitHub API client.  If a nil httpClient is
 // provided, http.DefaultClient will be used.  To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
-// for you (such as that provided by the goauth2 library).
+// for you (such as that provided by TokenAuthTransport, BasicAuthTransport or
+// the goauth2 library).
 func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
+	uploadURL, _ := url.Parse(uploadBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{client: httpClient, BaseURL: baseURL, UploadURL: uploadURL, UserAgent: userAgent}
 	c.Issues = &IssuesService{client: c}
 	c.Organizations = &OrganizationsService{client: c}
 	c.PullRequests = &PullRequestsService{client: c}
@@ -92,19 +279,69 @@ func NewClient(httpClient *http.Client) *Client {
 	return c
 }
 
+// NewEnterpriseClient returns a new GitHub API client for the GitHub Enterprise
+// instance at baseURL, such as "https://github.example.com".  The "/api/v3/"
+// prefix is added to baseURL, and "/api/uploads/" to uploadURL, unless they
+// already end with it.  If uploadURL is empty, uploads go to the same host as
+// baseURL.  An error is returned if either URL is not an absolute http or
+// https URL.  httpClient is used as in NewClient.
+func NewEnterpriseClient(baseURL, uploadURL string, httpClient *http.Client) (*Client, error) {
+	base, err := enterpriseURL(baseURL, "api/v3/")
+	if err != nil {
+		return nil, err
+	}
+
+	if uploadURL == "" {
+		// Upload to the same host, under the path GitHub Enterprise is
+		// served from.
+		u := *base
+		u.Path = strings.TrimSuffix(u.Path, "api/v3/")
+		uploadURL = u.String()
+	}
+	upload, err := enterpriseURL(uploadURL, "api/uploads/")
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewClient(httpClient)
+	c.BaseURL = base
+	c.UploadURL = upload
+	return c, nil
+}
+
+// enterpriseURL parses rawurl, and ensures its path ends with a slash followed
+// by prefix.
+func enterpriseURL(rawurl, prefix string) (*url.URL, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("github: enterprise URL %q must be an absolute http or https URL", rawurl)
+	}
+
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	if !strings.HasSuffix(u.Path, "/"+prefix) {
+		u.Path += prefix
+	}
+	u.RawPath = ""
+	return u, nil
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash.  If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
+// request body.  The body is buffered, and req.GetBody is set so that it can
+// be replayed when the request is retried.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
+	u, err := resolveURL(c.BaseURL, urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	u := c.BaseURL.ResolveReference(rel)
-
 	buf := new(bytes.Buffer)
 	if body != nil {
 		err := json.NewEncoder(buf).Encode(body)
@@ -122,6 +359,47 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// NewUploadRequest creates an upload request.  A relative URL can be provided
+// in urlStr, in which case it is resolved relative to the UploadURL of the
+// Client.  The size bytes read from reader are sent as the request body, with
+// the given media type as its Content-Type.
+func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, mediaType string) (*http.Request, error) {
+	u, err := resolveURL(c.UploadURL, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	req.Header.Add("Content-Type", mediaType)
+	req.Header.Add("User-Agent", c.UserAgent)
+	return req, nil
+}
+
+// resolveURL resolves urlStr relative to base, which must have a trailing
+// slash so that its last path element is kept.
+func resolveURL(base *url.URL, urlStr string) (*url.URL, error) {
+	if base == nil {
+		return nil, errors.New("github: base URL is not set")
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		return nil, fmt.Errorf("github: base URL must have a trailing slash, but %q does not", base)
+	}
+
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	return base.ResolveReference(rel), nil
+}
+
 // Response is a GitHub API response.  This wraps the standard http.Response
 // returned from GitHub and provides convenient access to things like
 // pagination links.
@@ -138,6 +416,15 @@ type Response struct {
 	FirstPage int
 	LastPage  int
 
+	// FromCache reports whether the response body was served from
+	// Client.Cache after GitHub answered a conditional request with 304 Not
+	// Modified.
+	FromCache bool
+
+	// PollInterval is the time GitHub asks clients to wait before polling
+	// the resource again, from the X-Poll-Interval header, or zero if absent.
+	PollInterval time.Duration
+
 	Rate
 }
 
@@ -146,6 +433,9 @@ func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
 	response.populateRate()
+	if secs, err := strconv.Atoi(r.Header.Get(headerPollInterval)); err == nil && secs > 0 {
+		response.PollInterval = time.Duration(secs) * time.Second
+	}
 	return response
 }
 
@@ -195,47 +485,135 @@ func (r *Response) populatePageValues() {
 
 // populateRate parses the rate related headers and populates the response Rate.
 func (r *Response) populateRate() {
-	if limit := r.Header.Get(headerRateLimit); limit != "" {
-		r.Rate.Limit, _ = strconv.Atoi(limit)
+	r.Rate = parseRate(r.Header)
+}
+
+// parseRate parses the rate limit headers in h.
+func parseRate(h http.Header) Rate {
+	var rate Rate
+	if limit := h.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
 	}
-	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
-		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+	if remaining := h.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
 	}
-	if reset := r.Header.Get(headerRateReset); reset != "" {
+	if reset := h.Get(headerRateReset); reset != "" {
 		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
-			r.Rate.Reset = time.Unix(v, 0)
+			rate.Reset = time.Unix(v, 0)
 		}
 	}
+	return rate
 }
 
 // Do sends an API request and returns the API response.  The API response is
 // decoded and stored in the value pointed to by v, or returned as an error if
-// an API error has occurred.
-func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+// an API error has occurred.  If v implements the io.Writer interface, the raw
+// response body is written to v instead, without decoding.
+//
+// The provided ctx must be non-nil.  If it is canceled or times out, the
+// request is aborted and ctx.Err() is returned, both while waiting for the
+// response and while decoding its body.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	response, err := c.do(ctx, req, true)
 	if err != nil {
+		return response, err
+	}
+	defer response.Body.Close()
+
+	if w, ok := v.(io.Writer); ok {
+		_, err = io.Copy(w, response.Body)
+	} else if v != nil {
+		err = json.NewDecoder(response.Body).Decode(v)
+	}
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		err = ctxErr
+	}
+	return response, err
+}
+
+// DoStream sends an API request like Do, but returns the API response with
+// its body unread, so that large responses such as archives can be processed
+// as they arrive rather than buffered.  Pagination and rate limit values are
+// parsed from the headers as usual.  The caller must close the response body.
+//
+// Responses are never served from or stored in Client.Cache.  If an API error
+// has occurred, the body has already been read and closed, and the response
+// is returned along with the error.
+//
+// Big JSON arrays can be decoded one element at a time:
+//
+//	resp, err := client.DoStream(ctx, req)
+//	if err != nil {
+//		return err
+//	}
+//	defer resp.Body.Close()
+//	dec := json.NewDecoder(resp.Body)
+//	if _, err := dec.Token(); err != nil { // [
+//		return err
+//	}
+//	for dec.More() {
+//		var repo github.Repository
+//		if err := dec.Decode(&repo); err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (c *Client) DoStream(ctx context.Context, req *http.Request) (*Response, error) {
+	return c.do(ctx, req, false)
+}
+
+// do sends an API request and returns the API response with its body unread,
+// unless an API error has occurred.  The cache is used only if useCache is
+// true.
+func (c *Client) do(ctx context.Context, req *http.Request, useCache bool) (*Response, error) {
+	if ctx == nil {
+		return nil, errNilContext
+	}
+	req = req.WithContext(c.withRequestInfo(ctx, req))
+	setBodyFormat(ctx, req)
+
+	if err := c.checkRateLimit(ctx, req); err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
+	cacheable := useCache && c.cacheable(req)
+	var cached *cacheEntry
+	if cacheable {
+		cached = c.addConditionalHeaders(req)
+	}
+
+	var fromCache bool
+	resp, err := c.send(ctx, req)
+	if err == nil && cacheable {
+		resp, fromCache, err = c.updateCache(req, resp, cached)
+	}
+	if err != nil {
+		// If the context has been canceled, its error is more useful than
+		// the transport error it caused.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
 
 	response := newResponse(resp)
+	response.FromCache = fromCache
 
-	c.Rate = response.Rate
+	c.setRate(c.rateCategory(req), response.Rate)
 
 	err = CheckResponse(resp)
 	if err != nil {
+		resp.Body.Close()
 		// even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
 		return response, err
 	}
-
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
-	}
-	return response, err
+	return response, nil
 }
 
+// errNilContext is returned by Do when it is called with a nil context.
+var errNilContext = errors.New("context must be non-nil")
+
 /*
 An ErrorResponse reports one or more errors caused by an API request.
 
@@ -245,6 +623,9 @@ type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
 	Message  string         `json:"message"` // error message
 	Errors   []Error        `json:"errors"`  // more detail on individual errors
+
+	// DocumentationURL links to the GitHub API docs for the failed request.
+	DocumentationURL string `json:"documentation_url,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -253,6 +634,28 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Message, r.Errors)
 }
 
+// RateLimitError occurs when GitHub returns a 403 Forbidden response with no
+// requests remaining in the current rate limit, or when Do refuses to send a
+// request because the rate limit is known to be exhausted.
+//
+// GitHub API docs: http://developer.github.com/v3/#rate-limiting
+type RateLimitError struct {
+	*ErrorResponse
+	Rate Rate // rate limit at the time of the error
+}
+
+func (r *RateLimitError) Error() string {
+	return fmt.Sprintf("%v %v: %d %v; rate limit resets at %v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Message, r.Rate.Reset)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (r *RateLimitError) Unwrap() error { return r.ErrorResponse }
+
+// Is reports whether target is ErrRateLimit.
+func (r *RateLimitError) Is(target error) bool { return target == ErrRateLimit }
+
 /*
 An Error reports more details on an individual error in an ErrorResponse.
 These are the possible validation error codes:
@@ -284,6 +687,13 @@ func (e *Error) Error() string {
 // the 200 range.  API error responses are expected to have either no response
 // body, or a JSON response body that maps to ErrorResponse.  Any other
 // response body will be silently ignored.
+//
+// Responses with some status codes are reported as more specific error types
+// wrapping the *ErrorResponse: *UnauthorizedError (401), *NotFoundError (404),
+// *ConflictError (409), *ValidationError (422), *ServerError (5xx) and, for
+// 403 Forbidden, *AbuseRateLimitError.  A 403 Forbidden response reporting no
+// remaining requests in the rate limit is returned as a *RateLimitError, which
+// also wraps the *ErrorResponse.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
@@ -293,7 +703,10 @@ func CheckResponse(r *http.Response) error {
 	if err == nil && data != nil {
 		json.Unmarshal(data, errorResponse)
 	}
-	return errorResponse
+	if r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0" {
+		return &RateLimitError{ErrorResponse: errorResponse, Rate: parseRate(r.Header)}
+	}
+	return typedError(errorResponse)
 }
 
 // parseBoolResponse determines the boolean result from a GitHub API response.
@@ -306,7 +719,8 @@ func parseBoolResponse(err error) (bool, error) {
 		return true, nil
 	}
 
-	if err, ok := err.(*ErrorResponse); ok && err.Response.StatusCode == http.StatusNotFound {
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusNotFound {
 		// Simply false.  In this one case, we do not pass the error through.
 		return false, nil
 	}
@@ -317,11 +731,22 @@ func parseBoolResponse(err error) (bool, error) {
 
 // API response wrapper to a rate limit request.
 type rateResponse struct {
-	Rate struct {
-		Limit     int   `json:"limit"`
-		Remaining int   `json:"remaining"`
-		Reset     int64 `json:"reset"`
-	} `json:"rate"`
+	Rate      rateJSON `json:"rate"`
+	Resources struct {
+		Core   rateJSON `json:"core"`
+		Search rateJSON `json:"search"`
+	} `json:"resources"`
+}
+
+// rateJSON is the representation of a Rate in the API.
+type rateJSON struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Reset     int64 `json:"reset"`
+}
+
+func (r rateJSON) rate() Rate {
+	return Rate{Limit: r.Limit, Remaining: r.Remaining, Reset: time.Unix(r.Reset, 0)}
 }
 
 // Rate represents the rate limit for the current client.  Unauthenticated
@@ -338,25 +763,163 @@ type Rate struct {
 	Reset time.Time
 }
 
+// Rates holds the rate limits of the categories of API requests, each of
+// which is counted separately.
+//
+// GitHub API docs: http://developer.github.com/v3/rate_limit/
+type Rates struct {
+	// Core is the rate limit for all requests except searches.
+	Core Rate
+
+	// Search is the rate limit for requests to the Search API.
+	Search Rate
+}
+
+// rateCategory identifies the category of API requests whose rate limit
+// applies to a request.
+type rateCategory int
+
+const (
+	coreCategory rateCategory = iota
+	searchCategory
+)
+
+// rateCategory returns the category of req.
+func (c *Client) rateCategory(req *http.Request) rateCategory {
+	if strings.HasPrefix(req.URL.Path, c.BaseURL.Path+"search/") {
+		return searchCategory
+	}
+	return coreCategory
+}
+
+// Rates returns the rate limits of the client as reported by the most recent
+// response in each category.  If the client is used in a multi-user
+// application, the rates may not always be up-to-date.  Call RateLimits to
+// check the current rates.
+func (c *Client) Rates() Rates {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rates
+}
+
+// rate returns the last known rate limit of the given category.
+func (c *Client) rate(category rateCategory) Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if category == searchCategory {
+		return c.rates.Search
+	}
+	return c.rates.Core
+}
+
+// setRate records rate as the rate limit of the given category.
+func (c *Client) setRate(category rateCategory, rate Rate) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if category == searchCategory {
+		c.rates.Search = rate
+	} else {
+		c.rates.Core = rate
+	}
+}
+
+// RateLimitPolicy specifies how a Client handles requests made while its rate
+// limit is known to be exhausted.
+type RateLimitPolicy int
+
+const (
+	// RateLimitIgnore sends requests regardless of the known rate limit.  Once
+	// the limit is exhausted, GitHub answers them with a *RateLimitError.
+	RateLimitIgnore RateLimitPolicy = iota
+
+	// RateLimitFail returns a *RateLimitError without sending the request.
+	RateLimitFail
+
+	// RateLimitWait sleeps until the rate limit resets, or until the request
+	// context is done, and then sends the request.
+	RateLimitWait
+)
+
+// checkRateLimit applies c.RateLimitPolicy to req, based on the rate limit
+// reported by the most recent response in the category of req.  Requests for
+// the rate limit itself never count against it, so they are always let
+// through.
+func (c *Client) checkRateLimit(ctx context.Context, req *http.Request) error {
+	if c.RateLimitPolicy == RateLimitIgnore || strings.HasSuffix(req.URL.Path, "/rate_limit") {
+		return nil
+	}
+
+	rate := c.rate(c.rateCategory(req))
+	if rate.Remaining > 0 || rate.Limit == 0 || !time.Now().Before(rate.Reset) {
+		return nil
+	}
+
+	if c.RateLimitPolicy == RateLimitWait {
+		t := time.NewTimer(rate.Reset.Sub(time.Now()))
+		defer t.Stop()
+		select {
+		case <-t.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return &RateLimitError{
+		ErrorResponse: &ErrorResponse{
+			Response: &http.Response{
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Request:    req,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			},
+			Message: "request not sent: API rate limit exceeded",
+		},
+		Rate: rate,
+	}
+}
+
 // RateLimit returns the rate limit for the current client.
-func (c *Client) RateLimit() (*Rate, *Response, error) {
+func (c *Client) RateLimit(ctx context.Context) (*Rate, *Response, error) {
 	req, err := c.NewRequest("GET", "rate_limit", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	response := new(rateResponse)
-	resp, err := c.Do(req, response)
+	resp, err := c.Do(ctx, req, response)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	rate := &Rate{
-		Limit:     response.Rate.Limit,
-		Remaining: response.Rate.Remaining,
-		Reset:     time.Unix(response.Rate.Reset, 0),
+	rate := response.Rate.rate()
+	return &rate, resp, err
+}
+
+// RateLimits returns the rate limits of each category of requests for the
+// current client, and records them as the client's current Rates.  Requests
+// for the rate limits do not count against them.
+func (c *Client) RateLimits(ctx context.Context) (*Rates, *Response, error) {
+	req, err := c.NewRequest("GET", "rate_limit", nil)
+	if err != nil {
+		return nil, nil, err
 	}
-	return rate, resp, err
+
+	response := new(rateResponse)
+	resp, err := c.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rates := &Rates{
+		Core:   response.Resources.Core.rate(),
+		Search: response.Resources.Search.rate(),
+	}
+	c.rateMu.Lock()
+	c.rates = *rates
+	c.rateMu.Unlock()
+	return rates, resp, nil
 }
 
 /*
@@ -425,6 +988,144 @@ func (t *UnauthenticatedRateLimitedTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+/*
+TokenAuthTransport authenticates requests with an OAuth token, such as a
+personal access token.
+
+	t := &github.TokenAuthTransport{Token: "your token"}
+	client := github.NewClient(t.Client())
+
+This will set the header "Authorization: token xxx" on requests to the API
+and uploads hosts of the client, but not on redirects to other hosts.
+
+See http://developer.github.com/v3/#authentication for more information.
+*/
+type TokenAuthTransport struct {
+	// Token is the OAuth token sent with every request to GitHub.
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Token == "" {
+		return nil, errors.New("Token is empty")
+	}
+
+	if !sendCredentials(req) {
+		return t.transport().RoundTrip(req)
+	}
+	req = cloneRequest(req) // per RoundTrip contract
+	req.Header.Set("Authorization", "token "+t.Token)
+	return t.transport().RoundTrip(req)
+}
+
+// Client returns an *http.Client that makes requests authenticated with the
+// token.
+func (t *TokenAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *TokenAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+/*
+BasicAuthTransport authenticates requests with HTTP Basic authentication,
+using a username and password.
+
+	t := &github.BasicAuthTransport{
+		Username: "your username",
+		Password: "your password",
+	}
+	client := github.NewClient(t.Client())
+
+Accounts with two-factor authentication enabled must also provide a one-time
+password.  Requests made without one fail with a *TwoFactorAuthError, after
+which the code can be requested from the user and the request retried:
+
+	_, _, err := client.Users.Get(ctx, "")
+	if errors.Is(err, github.ErrTwoFactorAuth) {
+		t.OTP = promptForCode()
+		_, _, err = client.Users.Get(ctx, "")
+	}
+
+See http://developer.github.com/v3/auth/#basic-authentication for more
+information.
+*/
+type BasicAuthTransport struct {
+	// Username and Password are the credentials sent with every request to
+	// GitHub.  Like the OTP, they are not sent on redirects to other hosts.
+	Username string
+	Password string
+
+	// OTP is the one-time password for accounts with two-factor
+	// authentication enabled.  If set, it is sent in the X-GitHub-OTP header.
+	OTP string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Username == "" {
+		return nil, errors.New("Username is empty")
+	}
+
+	if !sendCredentials(req) {
+		return t.transport().RoundTrip(req)
+	}
+	req = cloneRequest(req) // per RoundTrip contract
+	req.SetBasicAuth(t.Username, t.Password)
+	if t.OTP != "" {
+		req.Header.Set(headerOTP, t.OTP)
+	}
+	return t.transport().RoundTrip(req)
+}
+
+// Client returns an *http.Client that makes requests authenticated with the
+// username and password.
+func (t *BasicAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *BasicAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// sendCredentials reports whether an authenticating transport may add
+// credentials to req.  Requests sent by a Client are only authenticated if
+// they go to its API or uploads host, so that redirects to other hosts, such
+// as the storage of release assets, do not receive the credentials.  Other
+// requests are only authenticated if they go to the host the first request
+// of their redirect chain was sent to.
+func sendCredentials(req *http.Request) bool {
+	if info, ok := req.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+		for _, h := range info.hosts {
+			if req.URL.Host == h {
+				return true
+			}
+		}
+		return false
+	}
+	first := req
+	for first.Response != nil && first.Response.Request != nil {
+		first = first.Response.Request
+	}
+	return first.URL.Host == req.URL.Host
+}
+
 // cloneRequest returns a clone of the provided *http.Request. The clone is a
 // shallow copy of the struct and its Header map.
 func cloneRequest(r *http.Request) *http.Request {
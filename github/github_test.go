@@ -6,14 +6,19 @@
 package github
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -39,7 +44,9 @@ func setup() {
 
 	// github client configured to use test server
 	client = NewClient(nil)
-	client.BaseURL, _ = url.Parse(server.URL)
+	u, _ := url.Parse(server.URL + "/")
+	client.BaseURL = u
+	client.UploadURL = u
 }
 
 // teardown closes the test HTTP server.
@@ -84,11 +91,77 @@ func TestNewClient(t *testing.T) {
 	if c.BaseURL.String() != defaultBaseURL {
 		t.Errorf("NewClient BaseURL = %v, want %v", c.BaseURL.String(), defaultBaseURL)
 	}
+	if c.UploadURL.String() != uploadBaseURL {
+		t.Errorf("NewClient UploadURL = %v, want %v", c.UploadURL.String(), uploadBaseURL)
+	}
 	if c.UserAgent != userAgent {
 		t.Errorf("NewClient UserAgent = %v, want %v", c.UserAgent, userAgent)
 	}
 }
 
+func TestNewEnterpriseClient(t *testing.T) {
+	tests := []struct {
+		baseURL, uploadURL         string
+		wantBaseURL, wantUploadURL string
+	}{
+		{"https://ghe.example.com", "https://upload.example.com",
+			"https://ghe.example.com/api/v3/", "https://upload.example.com/api/uploads/"},
+		{"https://ghe.example.com/", "https://upload.example.com/",
+			"https://ghe.example.com/api/v3/", "https://upload.example.com/api/uploads/"},
+		{"https://ghe.example.com/api/v3", "https://upload.example.com/api/uploads",
+			"https://ghe.example.com/api/v3/", "https://upload.example.com/api/uploads/"},
+		{"https://ghe.example.com/api/v3/", "https://upload.example.com/api/uploads/",
+			"https://ghe.example.com/api/v3/", "https://upload.example.com/api/uploads/"},
+		{"http://ghe.example.com:8080/github", "",
+			"http://ghe.example.com:8080/github/api/v3/", "http://ghe.example.com:8080/github/api/uploads/"},
+		{"https://ghe.example.com/api/v3", "",
+			"https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/"},
+		{"https://ghe.example.com/api/v3/", "",
+			"https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/"},
+		{"http://ghe.example.com:8080/github/api/v3/", "",
+			"http://ghe.example.com:8080/github/api/v3/", "http://ghe.example.com:8080/github/api/uploads/"},
+	}
+
+	for _, tt := range tests {
+		c, err := NewEnterpriseClient(tt.baseURL, tt.uploadURL, nil)
+		if err != nil {
+			t.Errorf("NewEnterpriseClient(%q, %q) returned error: %v", tt.baseURL, tt.uploadURL, err)
+			continue
+		}
+		if got := c.BaseURL.String(); got != tt.wantBaseURL {
+			t.Errorf("NewEnterpriseClient(%q, %q) BaseURL = %v, want %v", tt.baseURL, tt.uploadURL, got, tt.wantBaseURL)
+		}
+		if got := c.UploadURL.String(); got != tt.wantUploadURL {
+			t.Errorf("NewEnterpriseClient(%q, %q) UploadURL = %v, want %v", tt.baseURL, tt.uploadURL, got, tt.wantUploadURL)
+		}
+	}
+}
+
+func TestNewEnterpriseClient_invalidURL(t *testing.T) {
+	for _, u := range []string{"ghe.example.com", "ftp://ghe.example.com", "/api/v3/", "%zz"} {
+		if _, err := NewEnterpriseClient(u, "https://upload.example.com", nil); err == nil {
+			t.Errorf("NewEnterpriseClient(%q) did not return an error", u)
+		}
+		if _, err := NewEnterpriseClient("https://ghe.example.com", u, nil); err == nil {
+			t.Errorf("NewEnterpriseClient upload URL %q did not return an error", u)
+		}
+	}
+}
+
+func TestNewEnterpriseClient_requests(t *testing.T) {
+	c, _ := NewEnterpriseClient("https://ghe.example.com", "", nil)
+
+	req, _ := c.NewRequest("GET", "users/u", nil)
+	if got, want := req.URL.String(), "https://ghe.example.com/api/v3/users/u"; got != want {
+		t.Errorf("NewRequest URL = %v, want %v", got, want)
+	}
+
+	req, _ = c.NewUploadRequest("repos/o/r/releases/1/assets?name=n", strings.NewReader("data"), 4, "")
+	if got, want := req.URL.String(), "https://ghe.example.com/api/uploads/repos/o/r/releases/1/assets?name=n"; got != want {
+		t.Errorf("NewUploadRequest URL = %v, want %v", got, want)
+	}
+}
+
 func TestNewRequest(t *testing.T) {
 	c := NewClient(nil)
 
@@ -114,11 +187,45 @@ func TestNewRequest(t *testing.T) {
 	}
 }
 
+func TestNewRequest_badBaseURL(t *testing.T) {
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse("https://ghe.example.com/api/v3")
+
+	if _, err := c.NewRequest("GET", "users/u", nil); err == nil {
+		t.Errorf("Expected error for BaseURL without a trailing slash")
+	}
+}
+
+func TestNewUploadRequest(t *testing.T) {
+	c := NewClient(nil)
+
+	req, err := c.NewUploadRequest("repos/o/r/releases/1/assets", strings.NewReader("data"), 4, "text/plain")
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+
+	if got, want := req.URL.String(), uploadBaseURL+"repos/o/r/releases/1/assets"; got != want {
+		t.Errorf("NewUploadRequest URL = %v, want %v", got, want)
+	}
+	if req.Method != "POST" {
+		t.Errorf("NewUploadRequest Method = %v, want POST", req.Method)
+	}
+	if req.ContentLength != 4 {
+		t.Errorf("NewUploadRequest ContentLength = %v, want 4", req.ContentLength)
+	}
+	if got := req.Header.Get("Content-Type"); got != "text/plain" {
+		t.Errorf("NewUploadRequest Content-Type = %v, want text/plain", got)
+	}
+	if got := req.Header.Get("User-Agent"); got != c.UserAgent {
+		t.Errorf("NewUploadRequest User-Agent = %v, want %v", got, c.UserAgent)
+	}
+}
+
 func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewClient(nil)
 
 	type T struct {
-		A map[int]interface{}
+		A chan int
 	}
 	_, err := c.NewRequest("GET", "/", &T{})
 
@@ -206,7 +313,7 @@ func TestDo(t *testing.T) {
 
 	req, _ := client.NewRequest("GET", "/", nil)
 	body := new(foo)
-	client.Do(req, body)
+	client.Do(context.Background(), req, body)
 
 	want := &foo{"a"}
 	if !reflect.DeepEqual(body, want) {
@@ -214,6 +321,165 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_writer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	buf := new(bytes.Buffer)
+	if _, err := client.Do(context.Background(), req, buf); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if want := `{"A":"a"}`; buf.String() != want {
+		t.Errorf("Response body = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestDo_bodyFormatKeepsAccept(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mimePreview)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept", mimePreview)
+	client.Do(WithBodyFormat(context.Background(), BodyHTML), req, nil)
+}
+
+func TestDo_bodyFormatKeepsRequestHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", "application/vnd.github.v3.html+json")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	client.Do(WithBodyFormat(context.Background(), BodyHTML), req, nil)
+	if v := req.Header.Get("Accept"); v != "" {
+		t.Errorf("Do set Accept = %q on the caller's request", v)
+	}
+}
+
+func TestGetRaw_unsupportedType(t *testing.T) {
+	_, _, err := NewClient(nil).getRaw(context.Background(), "/", RawType("json"))
+	if err == nil || !strings.HasPrefix(err.Error(), "github: ") {
+		t.Errorf("getRaw returned error %v, want one prefixed with \"github: \"", err)
+	}
+}
+
+func TestDoStream(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Cache = new(MemoryCache)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "If-None-Match", "")
+		w.Header().Set("ETag", `"e"`)
+		w.Header().Set("Link", `<https://api.github.com/?page=2>; rel="next"`)
+		w.Header().Set(headerRateRemaining, "42")
+		fmt.Fprint(w, `[{"A":"a"},{"A":"b"}]`)
+	})
+
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("GET", "/", nil)
+		resp, err := client.DoStream(context.Background(), req)
+		if err != nil {
+			t.Fatalf("DoStream returned error: %v", err)
+		}
+		if resp.NextPage != 2 || resp.Remaining != 42 || client.Rates().Core.Remaining != 42 {
+			t.Errorf("DoStream returned %+v, want NextPage 2 and Remaining 42", resp)
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("reading body returned error: %v", err)
+		}
+		if want := `[{"A":"a"},{"A":"b"}]`; string(body) != want {
+			t.Errorf("Response body = %q, want %q", body, want)
+		}
+	}
+}
+
+func TestDoStream_httpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"m"}`, 404)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.DoStream(context.Background(), req)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("DoStream returned error %v, want ErrNotFound", err)
+	}
+	if resp == nil || resp.StatusCode != 404 {
+		t.Errorf("DoStream returned response %+v, want 404", resp)
+	}
+}
+
+func TestDo_nilContext(t *testing.T) {
+	c := NewClient(nil)
+	req, _ := c.NewRequest("GET", "/", nil)
+	_, err := c.Do(nil, req, nil)
+
+	if err != errNilContext {
+		t.Errorf("Do returned error %v, want %v", err, errNilContext)
+	}
+}
+
+func TestDo_canceledContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+
+	if err != context.Canceled {
+		t.Errorf("Do returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDo_deadlineExceededDuringDecode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	unblock := make(chan struct{})
+	defer close(unblock)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":`)
+		w.(http.Flusher).Flush()
+		<-unblock
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, new(struct{ A string }))
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
 func TestDo_httpError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -223,7 +489,7 @@ func TestDo_httpError(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 
 	if err == nil {
 		t.Error("Expected HTTP 400 error.")
@@ -242,7 +508,7 @@ func TestDo_redirectLoop(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 
 	if err == nil {
 		t.Error("Expected error to be returned.")
@@ -264,28 +530,28 @@ func TestDo_rateLimit(t *testing.T) {
 
 	var want int
 
-	if want = 0; client.Rate.Limit != want {
-		t.Errorf("Client rate limit = %v, want %v", client.Rate.Limit, want)
+	if want = 0; client.Rates().Core.Limit != want {
+		t.Errorf("Client rate limit = %v, want %v", client.Rates().Core.Limit, want)
 	}
-	if want = 0; client.Rate.Limit != want {
-		t.Errorf("Client rate remaining = %v, got %v", client.Rate.Remaining, want)
+	if want = 0; client.Rates().Core.Limit != want {
+		t.Errorf("Client rate remaining = %v, got %v", client.Rates().Core.Remaining, want)
 	}
-	if !client.Rate.Reset.IsZero() {
+	if !client.Rates().Core.Reset.IsZero() {
 		t.Errorf("Client rate reset not initialized to zero value")
 	}
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	client.Do(req, nil)
+	client.Do(context.Background(), req, nil)
 
-	if want = 60; client.Rate.Limit != want {
-		t.Errorf("Client rate limit = %v, want %v", client.Rate.Limit, want)
+	if want = 60; client.Rates().Core.Limit != want {
+		t.Errorf("Client rate limit = %v, want %v", client.Rates().Core.Limit, want)
 	}
-	if want = 59; client.Rate.Remaining != want {
-		t.Errorf("Client rate remaining = %v, want %v", client.Rate.Remaining, want)
+	if want = 59; client.Rates().Core.Remaining != want {
+		t.Errorf("Client rate remaining = %v, want %v", client.Rates().Core.Remaining, want)
 	}
 	reset := time.Date(2013, 7, 1, 17, 47, 53, 0, time.UTC)
-	if client.Rate.Reset.UTC() != reset {
-		t.Errorf("Client rate reset = %v, want %v", client.Rate.Reset, reset)
+	if client.Rates().Core.Reset.UTC() != reset {
+		t.Errorf("Client rate reset = %v, want %v", client.Rates().Core.Reset, reset)
 	}
 }
 
@@ -303,17 +569,136 @@ func TestDo_rateLimit_errorResponse(t *testing.T) {
 	var want int
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	client.Do(req, nil)
+	client.Do(context.Background(), req, nil)
 
-	if want = 60; client.Rate.Limit != want {
-		t.Errorf("Client rate limit = %v, want %v", client.Rate.Limit, want)
+	if want = 60; client.Rates().Core.Limit != want {
+		t.Errorf("Client rate limit = %v, want %v", client.Rates().Core.Limit, want)
 	}
-	if want = 59; client.Rate.Remaining != want {
-		t.Errorf("Client rate remaining = %v, want %v", client.Rate.Remaining, want)
+	if want = 59; client.Rates().Core.Remaining != want {
+		t.Errorf("Client rate remaining = %v, want %v", client.Rates().Core.Remaining, want)
 	}
 	reset := time.Date(2013, 7, 1, 17, 47, 53, 0, time.UTC)
-	if client.Rate.Reset.UTC() != reset {
-		t.Errorf("Client rate reset = %v, want %v", client.Rate.Reset, reset)
+	if client.Rates().Core.Reset.UTC() != reset {
+		t.Errorf("Client rate reset = %v, want %v", client.Rates().Core.Reset, reset)
+	}
+}
+
+func TestDo_rateLimitPolicyIgnore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	called := false
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	client.rates.Core = Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if !called {
+		t.Errorf("Request was not sent with RateLimitIgnore")
+	}
+}
+
+func TestDo_rateLimitPolicyFail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request sent while rate limit was exhausted")
+	})
+
+	rate := Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	client.rates.Core = rate
+	client.RateLimitPolicy = RateLimitFail
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(context.Background(), req, nil)
+
+	rateErr, ok := err.(*RateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *RateLimitError, got %#v", err)
+	}
+	if rateErr.Rate != rate {
+		t.Errorf("RateLimitError.Rate = %v, want %v", rateErr.Rate, rate)
+	}
+	if rateErr.Error() == "" {
+		t.Errorf("Expected non-empty RateLimitError.Error()")
+	}
+}
+
+func TestDo_rateLimitPolicyFail_resetPassed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	called := false
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	client.rates.Core = Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(-time.Second)}
+	client.RateLimitPolicy = RateLimitFail
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if !called {
+		t.Errorf("Request was not sent after the rate limit reset")
+	}
+}
+
+func TestDo_rateLimitPolicyFail_rateLimitEndpoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rate":{"limit":60,"remaining":0,"reset":1372700873}}`)
+	})
+
+	client.rates.Core = Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	client.RateLimitPolicy = RateLimitFail
+	if _, _, err := client.RateLimit(context.Background()); err != nil {
+		t.Errorf("RateLimit returned error: %v", err)
+	}
+}
+
+func TestDo_rateLimitPolicyWait(t *testing.T) {
+	setup()
+	defer teardown()
+
+	reset := time.Now().Add(50 * time.Millisecond)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if time.Now().Before(reset) {
+			t.Errorf("Request sent before the rate limit reset")
+		}
+	})
+
+	client.rates.Core = Rate{Limit: 60, Remaining: 0, Reset: reset}
+	client.RateLimitPolicy = RateLimitWait
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+}
+
+func TestDo_rateLimitPolicyWait_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request sent while rate limit was exhausted")
+	})
+
+	client.rates.Core = Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	client.RateLimitPolicy = RateLimitWait
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
 	}
 }
 
@@ -340,6 +725,45 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_rateLimit(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	res.Header.Set(headerRateLimit, "60")
+	res.Header.Set(headerRateRemaining, "0")
+	res.Header.Set(headerRateReset, "1372700873")
+	err, ok := CheckResponse(res).(*RateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *RateLimitError, got %#v", err)
+	}
+
+	want := &RateLimitError{
+		ErrorResponse: &ErrorResponse{Response: res, Message: "m"},
+		Rate: Rate{
+			Limit:     60,
+			Remaining: 0,
+			Reset:     time.Unix(1372700873, 0),
+		},
+	}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Error = %#v, want %#v", err, want)
+	}
+	if err.Error() == "" {
+		t.Errorf("Expected non-empty RateLimitError.Error()")
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Message != "m" {
+		t.Errorf("errors.As(%v, *ErrorResponse) = %+v, want the wrapped *ErrorResponse", err, errorResponse)
+	}
+	if !errors.Is(err, ErrRateLimit) {
+		t.Errorf("errors.Is(%v, ErrRateLimit) = false, want true", err)
+	}
+}
+
 // ensure that we properly handle API errors that do not contain a response
 // body
 func TestCheckResponse_noBody(t *testing.T) {
@@ -426,7 +850,7 @@ func TestRateLimit(t *testing.T) {
 		fmt.Fprint(w, `{"rate":{"limit":2,"remaining":1,"reset":1372700873}}`)
 	})
 
-	rate, _, err := client.RateLimit()
+	rate, _, err := client.RateLimit(context.Background())
 	if err != nil {
 		t.Errorf("Rate limit returned error: %v", err)
 	}
@@ -441,6 +865,117 @@ func TestRateLimit(t *testing.T) {
 	}
 }
 
+func TestRateLimits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"resources":{
+			"core":{"limit":2,"remaining":1,"reset":1372700873},
+			"search":{"limit":3,"remaining":2,"reset":1372700874}
+		}}`)
+	})
+
+	rates, _, err := client.RateLimits(context.Background())
+	if err != nil {
+		t.Errorf("RateLimits returned error: %v", err)
+	}
+
+	want := &Rates{
+		Core: Rate{
+			Limit:     2,
+			Remaining: 1,
+			Reset:     time.Date(2013, 7, 1, 17, 47, 53, 0, time.UTC).Local(),
+		},
+		Search: Rate{
+			Limit:     3,
+			Remaining: 2,
+			Reset:     time.Date(2013, 7, 1, 17, 47, 54, 0, time.UTC).Local(),
+		},
+	}
+	if !reflect.DeepEqual(rates, want) {
+		t.Errorf("RateLimits returned %+v, want %+v", rates, want)
+	}
+	if got := client.Rates(); !reflect.DeepEqual(got, *want) {
+		t.Errorf("Rates returned %+v, want %+v", got, *want)
+	}
+}
+
+func TestDo_rateCategories(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "30")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	})
+	mux.HandleFunc("/users/u", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+	})
+
+	client.RateLimitPolicy = RateLimitFail
+
+	req, _ := client.NewRequest("GET", "search/repositories", nil)
+	client.Do(context.Background(), req, nil)
+
+	rates := client.Rates()
+	if rates.Search.Limit != 30 || rates.Search.Remaining != 0 {
+		t.Errorf("Rates().Search = %+v, want limit 30 and remaining 0", rates.Search)
+	}
+	if rates.Core.Limit != 0 {
+		t.Errorf("Rates().Core = %+v, want zero value", rates.Core)
+	}
+
+	// the exhausted search limit does not block other requests
+	req, _ = client.NewRequest("GET", "users/u", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if got := client.Rates().Core.Remaining; got != 4999 {
+		t.Errorf("Rates().Core.Remaining = %v, want 4999", got)
+	}
+
+	req, _ = client.NewRequest("GET", "search/repositories", nil)
+	if _, err := client.Do(context.Background(), req, nil); !errors.Is(err, ErrRateLimit) {
+		t.Errorf("Do returned error %v, want a *RateLimitError", err)
+	}
+}
+
+// Run with the race detector to check that a Client is safe for concurrent
+// use.
+func TestDo_concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Cache = new(MemoryCache)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerETag, `"e"`)
+		fmt.Fprint(w, `{"login":"u"}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.Users.Get(context.Background(), "u"); err != nil {
+				t.Errorf("Users.Get returned error: %v", err)
+			}
+			client.Rates()
+		}()
+	}
+	wg.Wait()
+
+	if got := client.Rates().Core.Remaining; got != 59 {
+		t.Errorf("Rates().Core.Remaining = %v, want 59", got)
+	}
+}
+
 func TestUnauthenticatedRateLimitedTransport(t *testing.T) {
 	setup()
 	defer teardown()
@@ -463,7 +998,7 @@ func TestUnauthenticatedRateLimitedTransport(t *testing.T) {
 	unauthedClient := NewClient(tp.Client())
 	unauthedClient.BaseURL = client.BaseURL
 	req, _ := unauthedClient.NewRequest("GET", "/", nil)
-	unauthedClient.Do(req, nil)
+	unauthedClient.Do(context.Background(), req, nil)
 }
 
 func TestUnauthenticatedRateLimitedTransport_missingFields(t *testing.T) {
@@ -506,3 +1041,187 @@ func TestUnauthenticatedRateLimitedTransport_transport(t *testing.T) {
 		t.Errorf("Expected custom transport to be used.")
 	}
 }
+
+func TestTokenAuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+	})
+
+	tp := &TokenAuthTransport{Token: "t"}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+	req, _ := authedClient.NewRequest("GET", "/", nil)
+	authedClient.Do(context.Background(), req, nil)
+
+	if req.Header.Get("Authorization") != "" {
+		t.Errorf("TokenAuthTransport modified the original request")
+	}
+}
+
+func TestTokenAuthTransport_missingToken(t *testing.T) {
+	tp := &TokenAuthTransport{}
+	_, err := tp.RoundTrip(nil)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestBasicAuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "u" || password != "p" {
+			t.Errorf("BasicAuth = (%v, %v, %v), want (u, p, true)", username, password, ok)
+		}
+		testHeader(t, r, "X-GitHub-OTP", "123456")
+	})
+
+	tp := &BasicAuthTransport{Username: "u", Password: "p", OTP: "123456"}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+	req, _ := authedClient.NewRequest("GET", "/", nil)
+	authedClient.Do(context.Background(), req, nil)
+}
+
+func TestBasicAuthTransport_otpRequired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-GitHub-OTP") == "" {
+			w.Header().Set("X-GitHub-OTP", "required; app")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"Must specify two-factor authentication OTP code."}`)
+		}
+	})
+
+	tp := &BasicAuthTransport{Username: "u", Password: "p"}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+
+	req, _ := authedClient.NewRequest("GET", "/", nil)
+	_, err := authedClient.Do(context.Background(), req, nil)
+	if !errors.Is(err, ErrTwoFactorAuth) {
+		t.Fatalf("Expected a *TwoFactorAuthError, got %#v", err)
+	}
+
+	tp.OTP = "123456"
+	req, _ = authedClient.NewRequest("GET", "/", nil)
+	if _, err := authedClient.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error after setting OTP: %v", err)
+	}
+}
+
+func TestBasicAuthTransport_missingUsername(t *testing.T) {
+	tp := &BasicAuthTransport{Password: "p"}
+	_, err := tp.RoundTrip(nil)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestAuthTransports_foreignRedirect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range []string{"Authorization", "X-GitHub-OTP"} {
+			if v := r.Header.Get(h); v != "" {
+				t.Errorf("Foreign host received %v header %q", h, v)
+			}
+		}
+	}))
+	defer foreign.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			t.Errorf("API host received no Authorization header")
+		}
+		http.Redirect(w, r, foreign.URL+"/storage", http.StatusFound)
+	})
+
+	transports := map[string]http.RoundTripper{
+		"TokenAuthTransport": &TokenAuthTransport{Token: "t"},
+		"BasicAuthTransport": &BasicAuthTransport{Username: "u", Password: "p", OTP: "123456"},
+	}
+	for name, tp := range transports {
+		authedClient := NewClient(&http.Client{Transport: tp})
+		authedClient.BaseURL = client.BaseURL
+		req, _ := authedClient.NewRequest("GET", "/", nil)
+		if _, err := authedClient.Do(context.Background(), req, nil); err != nil {
+			t.Errorf("%v: Do returned error: %v", name, err)
+		}
+
+		// Requests not sent through a Client are only authenticated on the
+		// host they were first sent to.
+		resp, err := (&http.Client{Transport: tp}).Get(server.URL + "/")
+		if err != nil {
+			t.Errorf("%v: Get returned error: %v", name, err)
+			continue
+		}
+		resp.Body.Close()
+	}
+}
+
+func TestAddOptions(t *testing.T) {
+	type T struct {
+		Name   string    `url:"name,omitempty"`
+		Count  int       `url:"count"`
+		Flag   *bool     `url:"flag,omitempty"`
+		Labels []string  `url:"labels,comma,omitempty"`
+		IDs    []int     `url:"id,omitempty"`
+		Since  time.Time `url:"since,omitempty"`
+		Skip   string    `url:"-"`
+		Other  string
+		ListOptions
+	}
+
+	opt := &T{
+		Count:       0,
+		Flag:        Bool(false),
+		Labels:      []string{"a", "b"},
+		IDs:         []int{1, 2},
+		Since:       time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		Skip:        "s",
+		Other:       "o",
+		ListOptions: ListOptions{Page: 2, PerPage: 50},
+	}
+	u, err := addOptions("repos/o/r/issues?q=x", opt)
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+
+	want := "repos/o/r/issues?count=0&flag=false&id=1&id=2&labels=a%2Cb&page=2&per_page=50&q=x&since=2002-02-10T15%3A30%3A00Z"
+	if u != want {
+		t.Errorf("addOptions returned %v, want %v", u, want)
+	}
+}
+
+func TestAddOptions_nil(t *testing.T) {
+	var opt *ListOptions
+	u, err := addOptions("users", opt)
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+	if want := "users"; u != want {
+		t.Errorf("addOptions returned %v, want %v", u, want)
+	}
+}
+
+func TestAddOptions_invalid(t *testing.T) {
+	if _, err := addOptions("users", 1); err == nil {
+		t.Errorf("Expected error for non-struct options")
+	}
+
+	type T struct {
+		M map[string]string `url:"m"`
+	}
+	if _, err := addOptions("users", &T{M: map[string]string{}}); err == nil {
+		t.Errorf("Expected error for unsupported field type")
+	}
+}
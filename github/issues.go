@@ -6,10 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -27,6 +25,8 @@ type Issue struct {
 	State     *string    `json:"state,omitempty"`
 	Title     *string    `json:"title,omitempty"`
 	Body      *string    `json:"body,omitempty"`
+	BodyHTML  *string    `json:"body_html,omitempty"` // see WithBodyFormat
+	BodyText  *string    `json:"body_text,omitempty"`
 	User      *User      `json:"user,omitempty"`
 	Labels    []Label    `json:"labels,omitempty"`
 	Assignee  *User      `json:"assignee,omitempty"`
@@ -47,28 +47,27 @@ func (i Issue) String() string {
 type IssueListOptions struct {
 	// Filter specifies which issues to list.  Possible values are: assigned,
 	// created, mentioned, subscribed, all.  Default is "assigned".
-	Filter string
+	Filter string `url:"filter,omitempty"`
 
 	// State filters issues based on their state.  Possible values are: open,
 	// closed.  Default is "open".
-	State string
+	State string `url:"state,omitempty"`
 
 	// Labels filters issues based on their label.
-	Labels []string
+	Labels []string `url:"labels,comma,omitempty"`
 
 	// Sort specifies how to sort issues.  Possible values are: created, updated,
 	// and comments.  Default value is "assigned".
-	Sort string
+	Sort string `url:"sort,omitempty"`
 
 	// Direction in which to sort issues.  Possible values are: asc, desc.
 	// Default is "asc".
-	Direction string
+	Direction string `url:"direction,omitempty"`
 
 	// Since filters issues by time.
-	Since time.Time
+	Since time.Time `url:"since,omitempty"`
 
-	// For paginated result sets, page of results to retrieve.
-	Page int
+	ListOptions
 }
 
 // List the issues for the authenticated user.  If all is true, list issues
@@ -77,39 +76,29 @@ type IssueListOptions struct {
 // repositories.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#list-issues
-func (s *IssuesService) List(all bool, opt *IssueListOptions) ([]Issue, *Response, error) {
+func (s *IssuesService) List(ctx context.Context, all bool, opt *IssueListOptions) ([]Issue, *Response, error) {
 	var u string
 	if all {
 		u = "issues"
 	} else {
 		u = "user/issues"
 	}
-	return s.listIssues(u, opt)
+	return s.listIssues(ctx, u, opt)
 }
 
 // ListByOrg fetches the issues in the specified organization for the
 // authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#list-issues
-func (s *IssuesService) ListByOrg(org string, opt *IssueListOptions) ([]Issue, *Response, error) {
+func (s *IssuesService) ListByOrg(ctx context.Context, org string, opt *IssueListOptions) ([]Issue, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/issues", org)
-	return s.listIssues(u, opt)
+	return s.listIssues(ctx, u, opt)
 }
 
-func (s *IssuesService) listIssues(u string, opt *IssueListOptions) ([]Issue, *Response, error) {
-	if opt != nil {
-		params := url.Values{
-			"filter":    {opt.Filter},
-			"state":     {opt.State},
-			"labels":    {strings.Join(opt.Labels, ",")},
-			"sort":      {opt.Sort},
-			"direction": {opt.Direction},
-			"page":      []string{strconv.Itoa(opt.Page)},
-		}
-		if !opt.Since.IsZero() {
-			params.Add("since", opt.Since.Format(time.RFC3339))
-		}
-		u += "?" + params.Encode()
+func (s *IssuesService) listIssues(ctx context.Context, u string, opt *IssueListOptions) ([]Issue, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -118,7 +107,7 @@ func (s *IssuesService) listIssues(u string, opt *IssueListOptions) ([]Issue, *R
 	}
 
 	issues := new([]Issue)
-	resp, err := s.client.Do(req, issues)
+	resp, err := s.client.Do(ctx, req, issues)
 	return *issues, resp, err
 }
 
@@ -128,58 +117,48 @@ type IssueListByRepoOptions struct {
 	// Milestone limits issues for the specified milestone.  Possible values are
 	// a milestone number, "none" for issues with no milestone, "*" for issues
 	// with any milestone.
-	Milestone string
+	Milestone string `url:"milestone,omitempty"`
 
 	// State filters issues based on their state.  Possible values are: open,
 	// closed.  Default is "open".
-	State string
+	State string `url:"state,omitempty"`
 
 	// Assignee filters issues based on their assignee.  Possible values are a
 	// user name, "none" for issues that are not assigned, "*" for issues with
 	// any assigned user.
-	Assignee string
+	Assignee string `url:"assignee,omitempty"`
 
 	// Assignee filters issues based on their creator.
-	Creator string
+	Creator string `url:"creator,omitempty"`
 
 	// Assignee filters issues to those mentioned a specific user.
-	Mentioned string
+	Mentioned string `url:"mentioned,omitempty"`
 
 	// Labels filters issues based on their label.
-	Labels []string
+	Labels []string `url:"labels,comma,omitempty"`
 
 	// Sort specifies how to sort issues.  Possible values are: created, updated,
 	// and comments.  Default value is "assigned".
-	Sort string
+	Sort string `url:"sort,omitempty"`
 
 	// Direction in which to sort issues.  Possible values are: asc, desc.
 	// Default is "asc".
-	Direction string
+	Direction string `url:"direction,omitempty"`
 
 	// Since filters issues by time.
-	Since time.Time
+	Since time.Time `url:"since,omitempty"`
+
+	ListOptions
 }
 
 // ListByRepo lists the issues for the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#list-issues-for-a-repository
-func (s *IssuesService) ListByRepo(owner string, repo string, opt *IssueListByRepoOptions) ([]Issue, *Response, error) {
+func (s *IssuesService) ListByRepo(ctx context.Context, owner string, repo string, opt *IssueListByRepoOptions) ([]Issue, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"milestone": {opt.Milestone},
-			"state":     {opt.State},
-			"assignee":  {opt.Assignee},
-			"creator":   {opt.Creator},
-			"mentioned": {opt.Mentioned},
-			"labels":    {strings.Join(opt.Labels, ",")},
-			"sort":      {opt.Sort},
-			"direction": {opt.Direction},
-		}
-		if !opt.Since.IsZero() {
-			params.Add("since", opt.Since.Format(time.RFC3339))
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -188,48 +167,48 @@ func (s *IssuesService) ListByRepo(owner string, repo string, opt *IssueListByRe
 	}
 
 	issues := new([]Issue)
-	resp, err := s.client.Do(req, issues)
+	resp, err := s.client.Do(ctx, req, issues)
 	return *issues, resp, err
 }
 
 // Get a single issue.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#get-a-single-issue
-func (s *IssuesService) Get(owner string, repo string, number int) (*Issue, *Response, error) {
+func (s *IssuesService) Get(ctx context.Context, owner string, repo string, number int) (*Issue, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	issue := new(Issue)
-	resp, err := s.client.Do(req, issue)
+	resp, err := s.client.Do(ctx, req, issue)
 	return issue, resp, err
 }
 
 // Create a new issue on the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#create-an-issue
-func (s *IssuesService) Create(owner string, repo string, issue *Issue) (*Issue, *Response, error) {
+func (s *IssuesService) Create(ctx context.Context, owner string, repo string, issue *Issue) (*Issue, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues", owner, repo)
 	req, err := s.client.NewRequest("POST", u, issue)
 	if err != nil {
 		return nil, nil, err
 	}
 	i := new(Issue)
-	resp, err := s.client.Do(req, i)
+	resp, err := s.client.Do(ctx, req, i)
 	return i, resp, err
 }
 
 // Edit an issue.
 //
 // GitHub API docs: http://developer.github.com/v3/issues/#edit-an-issue
-func (s *IssuesService) Edit(owner string, repo string, number int, issue *Issue) (*Issue, *Response, error) {
+func (s *IssuesService) Edit(ctx context.Context, owner string, repo string, number int, issue *Issue) (*Issue, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
 	req, err := s.client.NewRequest("PATCH", u, issue)
 	if err != nil {
 		return nil, nil, err
 	}
 	i := new(Issue)
-	resp, err := s.client.Do(req, i)
+	resp, err := s.client.Do(ctx, req, i)
 	return i, resp, err
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -18,10 +19,12 @@ func TestIssuesService_ListAssignees(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/assignees", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	assignees, _, err := client.Issues.ListAssignees("o", "r")
+	opt := &ListOptions{Page: 2}
+	assignees, _, err := client.Issues.ListAssignees(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Issues.List returned error: %v", err)
 	}
@@ -33,7 +36,7 @@ func TestIssuesService_ListAssignees(t *testing.T) {
 }
 
 func TestIssuesService_ListAssignees_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.ListAssignees("%", "r")
+	_, _, err := client.Issues.ListAssignees(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -45,7 +48,7 @@ func TestIssuesService_IsAssignee_true(t *testing.T) {
 		testMethod(t, r, "GET")
 	})
 
-	assignee, _, err := client.Issues.IsAssignee("o", "r", "u")
+	assignee, _, err := client.Issues.IsAssignee(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Issues.IsAssignee returned error: %v", err)
 	}
@@ -63,7 +66,7 @@ func TestIssuesService_IsAssignee_false(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	assignee, _, err := client.Issues.IsAssignee("o", "r", "u")
+	assignee, _, err := client.Issues.IsAssignee(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Issues.IsAssignee returned error: %v", err)
 	}
@@ -81,7 +84,7 @@ func TestIssuesService_IsAssignee_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	assignee, _, err := client.Issues.IsAssignee("o", "r", "u")
+	assignee, _, err := client.Issues.IsAssignee(context.Background(), "o", "r", "u")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -91,6 +94,6 @@ func TestIssuesService_IsAssignee_error(t *testing.T) {
 }
 
 func TestIssuesService_IsAssignee_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.IsAssignee("%", "r", "u")
+	_, _, err := client.Issues.IsAssignee(context.Background(), "%", "r", "u")
 	testURLParseError(t, err)
 }
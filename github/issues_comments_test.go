@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,14 +25,16 @@ func TestIssuesService_ListComments_allIssues(t *testing.T) {
 			"sort":      "updated",
 			"direction": "desc",
 			"since":     "2002-02-10T15:30:00Z",
+			"page":      "2",
 		})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
 	opt := &IssueListCommentsOptions{"updated", "desc",
 		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		ListOptions{Page: 2},
 	}
-	comments, _, err := client.Issues.ListComments("o", "r", 0, opt)
+	comments, _, err := client.Issues.ListComments(context.Background(), "o", "r", 0, opt)
 	if err != nil {
 		t.Errorf("Issues.ListComments returned error: %v", err)
 	}
@@ -51,7 +54,7 @@ func TestIssuesService_ListComments_specificIssue(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	comments, _, err := client.Issues.ListComments("o", "r", 1, nil)
+	comments, _, err := client.Issues.ListComments(context.Background(), "o", "r", 1, nil)
 	if err != nil {
 		t.Errorf("Issues.ListComments returned error: %v", err)
 	}
@@ -62,8 +65,30 @@ func TestIssuesService_ListComments_specificIssue(t *testing.T) {
 	}
 }
 
+func TestIssuesService_ListComments_bodyFormat(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.text+json")
+		fmt.Fprint(w, `[{"id":1, "body_text":"b"}]`)
+	})
+
+	ctx := WithBodyFormat(context.Background(), BodyText)
+	comments, _, err := client.Issues.ListComments(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("Issues.ListComments returned error: %v", err)
+	}
+
+	want := []IssueComment{{ID: Int(1), BodyText: String("b")}}
+	if !reflect.DeepEqual(comments, want) {
+		t.Errorf("Issues.ListComments returned %+v, want %+v", comments, want)
+	}
+}
+
 func TestIssuesService_ListComments_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.ListComments("%", "r", 1, nil)
+	_, _, err := client.Issues.ListComments(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -76,7 +101,7 @@ func TestIssuesService_GetComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Issues.GetComment("o", "r", 1)
+	comment, _, err := client.Issues.GetComment(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Issues.GetComment returned error: %v", err)
 	}
@@ -88,7 +113,7 @@ func TestIssuesService_GetComment(t *testing.T) {
 }
 
 func TestIssuesService_GetComment_invalidOrg(t *testing.T) {
-	_, _, err := client.Issues.GetComment("%", "r", 1)
+	_, _, err := client.Issues.GetComment(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
 
@@ -110,7 +135,7 @@ func TestIssuesService_CreateComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Issues.CreateComment("o", "r", 1, input)
+	comment, _, err := client.Issues.CreateComment(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Issues.CreateComment returned error: %v", err)
 	}
@@ -122,7 +147,7 @@ func TestIssuesService_CreateComment(t *testing.T) {
 }
 
 func TestIssuesService_CreateComment_invalidOrg(t *testing.T) {
-	_, _, err := client.Issues.CreateComment("%", "r", 1, nil)
+	_, _, err := client.Issues.CreateComment(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -144,7 +169,7 @@ func TestIssuesService_EditComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Issues.EditComment("o", "r", 1, input)
+	comment, _, err := client.Issues.EditComment(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Issues.EditComment returned error: %v", err)
 	}
@@ -156,7 +181,7 @@ func TestIssuesService_EditComment(t *testing.T) {
 }
 
 func TestIssuesService_EditComment_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.EditComment("%", "r", 1, nil)
+	_, _, err := client.Issues.EditComment(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -168,13 +193,13 @@ func TestIssuesService_DeleteComment(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Issues.DeleteComment("o", "r", 1)
+	_, err := client.Issues.DeleteComment(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Issues.DeleteComments returned error: %v", err)
 	}
 }
 
 func TestIssuesService_DeleteComment_invalidOwner(t *testing.T) {
-	_, err := client.Issues.DeleteComment("%", "r", 1)
+	_, err := client.Issues.DeleteComment(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestIssuesService_ListLabels(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"name": "a"},{"name": "b"}]`)
 	})
 
-	labels, _, err := client.Issues.ListLabels("o", "r")
+	opt := &ListOptions{Page: 2}
+	labels, _, err := client.Issues.ListLabels(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Issues.ListLabels returned error: %v", err)
 	}
@@ -42,7 +45,7 @@ func TestIssuesService_GetLabel(t *testing.T) {
 		fmt.Fprint(w, `{"url":"u", "name": "n", "color": "c"}`)
 	})
 
-	label, _, err := client.Issues.GetLabel("o", "r", "n")
+	label, _, err := client.Issues.GetLabel(context.Background(), "o", "r", "n")
 	if err != nil {
 		t.Errorf("Issues.GetLabel returned error: %v", err)
 	}
@@ -71,7 +74,7 @@ func TestIssuesService_CreateLabel(t *testing.T) {
 		fmt.Fprint(w, `{"url":"u"}`)
 	})
 
-	label, _, err := client.Issues.CreateLabel("o", "r", input)
+	label, _, err := client.Issues.CreateLabel(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("Issues.CreateLabel returned error: %v", err)
 	}
@@ -100,7 +103,7 @@ func TestIssuesService_EditLabel(t *testing.T) {
 		fmt.Fprint(w, `{"url":"u"}`)
 	})
 
-	label, _, err := client.Issues.EditLabel("o", "r", "n", input)
+	label, _, err := client.Issues.EditLabel(context.Background(), "o", "r", "n", input)
 	if err != nil {
 		t.Errorf("Issues.EditLabel returned error: %v", err)
 	}
@@ -119,7 +122,7 @@ func TestIssuesService_DeleteLabel(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Issues.DeleteLabel("o", "r", "n")
+	_, err := client.Issues.DeleteLabel(context.Background(), "o", "r", "n")
 	if err != nil {
 		t.Errorf("Issues.DeleteLabel returned error: %v", err)
 	}
@@ -131,10 +134,12 @@ func TestIssuesService_ListLabelsByIssue(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"name": "a"},{"name": "b"}]`)
 	})
 
-	labels, _, err := client.Issues.ListLabelsByIssue("o", "r", 1)
+	opt := &ListOptions{Page: 2}
+	labels, _, err := client.Issues.ListLabelsByIssue(context.Background(), "o", "r", 1, opt)
 	if err != nil {
 		t.Errorf("Issues.ListLabelsByIssue returned error: %v", err)
 	}
@@ -163,7 +168,7 @@ func TestIssuesService_AddLabelsToIssue(t *testing.T) {
 		fmt.Fprint(w, `[{"url":"u"}]`)
 	})
 
-	labels, _, err := client.Issues.AddLabelsToIssue("o", "r", 1, input)
+	labels, _, err := client.Issues.AddLabelsToIssue(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Issues.AddLabelsToIssue returned error: %v", err)
 	}
@@ -182,7 +187,7 @@ func TestIssuesService_RemoveLabelForIssue(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Issues.RemoveLabelForIssue("o", "r", 1, "l")
+	_, err := client.Issues.RemoveLabelForIssue(context.Background(), "o", "r", 1, "l")
 	if err != nil {
 		t.Errorf("Issues.RemoveLabelForIssue returned error: %v", err)
 	}
@@ -206,7 +211,7 @@ func TestIssuesService_ReplaceLabelsForIssue(t *testing.T) {
 		fmt.Fprint(w, `[{"url":"u"}]`)
 	})
 
-	labels, _, err := client.Issues.ReplaceLabelsForIssue("o", "r", 1, input)
+	labels, _, err := client.Issues.ReplaceLabelsForIssue(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Issues.ReplaceLabelsForIssue returned error: %v", err)
 	}
@@ -225,7 +230,7 @@ func TestIssuesService_RemoveLabelsForIssue(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Issues.RemoveLabelsForIssue("o", "r", 1)
+	_, err := client.Issues.RemoveLabelsForIssue(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Issues.RemoveLabelsForIssue returned error: %v", err)
 	}
@@ -237,10 +242,12 @@ func TestIssuesService_ListLabelsForMilestone(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/milestones/1/labels", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"name": "a"},{"name": "b"}]`)
 	})
 
-	labels, _, err := client.Issues.ListLabelsForMilestone("o", "r", 1)
+	opt := &ListOptions{Page: 2}
+	labels, _, err := client.Issues.ListLabelsForMilestone(context.Background(), "o", "r", 1, opt)
 	if err != nil {
 		t.Errorf("Issues.ListLabelsForMilestone returned error: %v", err)
 	}
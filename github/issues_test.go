@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,6 +29,7 @@ func TestIssuesService_List_all(t *testing.T) {
 			"direction": "asc",
 			"since":     "2002-02-10T15:30:00Z",
 			"page":      "1",
+			"per_page":  "2",
 		})
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
@@ -35,9 +37,9 @@ func TestIssuesService_List_all(t *testing.T) {
 	opt := &IssueListOptions{
 		"all", "closed", []string{"a", "b"}, "updated", "asc",
 		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
-		1,
+		ListOptions{Page: 1, PerPage: 2},
 	}
-	issues, _, err := client.Issues.List(true, opt)
+	issues, _, err := client.Issues.List(context.Background(), true, opt)
 
 	if err != nil {
 		t.Errorf("Issues.List returned error: %v", err)
@@ -58,7 +60,7 @@ func TestIssuesService_List_owned(t *testing.T) {
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
 
-	issues, _, err := client.Issues.List(false, nil)
+	issues, _, err := client.Issues.List(context.Background(), false, nil)
 	if err != nil {
 		t.Errorf("Issues.List returned error: %v", err)
 	}
@@ -78,7 +80,7 @@ func TestIssuesService_ListByOrg(t *testing.T) {
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
 
-	issues, _, err := client.Issues.ListByOrg("o", nil)
+	issues, _, err := client.Issues.ListByOrg(context.Background(), "o", nil)
 	if err != nil {
 		t.Errorf("Issues.ListByOrg returned error: %v", err)
 	}
@@ -90,7 +92,7 @@ func TestIssuesService_ListByOrg(t *testing.T) {
 }
 
 func TestIssuesService_ListByOrg_invalidOrg(t *testing.T) {
-	_, _, err := client.Issues.ListByOrg("%", nil)
+	_, _, err := client.Issues.ListByOrg(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -110,6 +112,7 @@ func TestIssuesService_ListByRepo(t *testing.T) {
 			"sort":      "updated",
 			"direction": "asc",
 			"since":     "2002-02-10T15:30:00Z",
+			"page":      "2",
 		})
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
@@ -117,8 +120,9 @@ func TestIssuesService_ListByRepo(t *testing.T) {
 	opt := &IssueListByRepoOptions{
 		"*", "closed", "a", "c", "m", []string{"a", "b"}, "updated", "asc",
 		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		ListOptions{Page: 2},
 	}
-	issues, _, err := client.Issues.ListByRepo("o", "r", opt)
+	issues, _, err := client.Issues.ListByRepo(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Issues.ListByOrg returned error: %v", err)
 	}
@@ -130,7 +134,7 @@ func TestIssuesService_ListByRepo(t *testing.T) {
 }
 
 func TestIssuesService_ListByRepo_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.ListByRepo("%", "r", nil)
+	_, _, err := client.Issues.ListByRepo(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -143,7 +147,7 @@ func TestIssuesService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"number":1, "labels": [{"url": "u", "name": "n", "color": "c"}]}`)
 	})
 
-	issue, _, err := client.Issues.Get("o", "r", 1)
+	issue, _, err := client.Issues.Get(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Issues.Get returned error: %v", err)
 	}
@@ -161,8 +165,30 @@ func TestIssuesService_Get(t *testing.T) {
 	}
 }
 
+func TestIssuesService_Get_bodyFormat(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.html+json")
+		fmt.Fprint(w, `{"number":1, "body_html": "<p>b</p>"}`)
+	})
+
+	ctx := WithBodyFormat(context.Background(), BodyHTML)
+	issue, _, err := client.Issues.Get(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Issues.Get returned error: %v", err)
+	}
+
+	want := &Issue{Number: Int(1), BodyHTML: String("<p>b</p>")}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("Issues.Get returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestIssuesService_Get_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.Get("%", "r", 1)
+	_, _, err := client.Issues.Get(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
 
@@ -184,7 +210,7 @@ func TestIssuesService_Create(t *testing.T) {
 		fmt.Fprint(w, `{"number":1}`)
 	})
 
-	issue, _, err := client.Issues.Create("o", "r", input)
+	issue, _, err := client.Issues.Create(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("Issues.Create returned error: %v", err)
 	}
@@ -196,7 +222,7 @@ func TestIssuesService_Create(t *testing.T) {
 }
 
 func TestIssuesService_Create_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.Create("%", "r", nil)
+	_, _, err := client.Issues.Create(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -218,7 +244,7 @@ func TestIssuesService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"number":1}`)
 	})
 
-	issue, _, err := client.Issues.Edit("o", "r", 1, input)
+	issue, _, err := client.Issues.Edit(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Issues.Edit returned error: %v", err)
 	}
@@ -230,6 +256,6 @@ func TestIssuesService_Edit(t *testing.T) {
 }
 
 func TestIssuesService_Edit_invalidOwner(t *testing.T) {
-	_, _, err := client.Issues.Edit("%", "r", 1, nil)
+	_, _, err := client.Issues.Edit(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
@@ -0,0 +1,150 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// mimeV3 is the prefix of the custom media types of version 3 of the API.
+const mimeV3 = "application/vnd.github.v3."
+
+// A BodyFormat selects how the Markdown bodies of issues, pull requests and
+// comments are represented in API responses.
+//
+// GitHub API docs: http://developer.github.com/v3/media/#comment-body-properties
+type BodyFormat string
+
+const (
+	// BodyRaw returns the Markdown source in Body.  This is what GitHub
+	// returns if no format is requested.
+	BodyRaw BodyFormat = "raw"
+
+	// BodyText returns a plain text rendering in BodyText.
+	BodyText BodyFormat = "text"
+
+	// BodyHTML returns an HTML rendering in BodyHTML.
+	BodyHTML BodyFormat = "html"
+
+	// BodyFull returns all three representations.
+	BodyFull BodyFormat = "full"
+)
+
+// bodyFormatKey is the context key of the BodyFormat requested by the caller.
+type bodyFormatKey struct{}
+
+// WithBodyFormat returns a copy of ctx that requests bodies in format f.  Any
+// method that returns issues, pull requests or comments may be called with
+// it:
+//
+//	ctx := github.WithBodyFormat(ctx, github.BodyHTML)
+//	issue, _, err := client.Issues.Get(ctx, "o", "r", 1)
+//	// *issue.BodyHTML holds the rendered body.
+func WithBodyFormat(ctx context.Context, f BodyFormat) context.Context {
+	return context.WithValue(ctx, bodyFormatKey{}, f)
+}
+
+// setBodyFormat sets the Accept header of req to the body format requested
+// in ctx, unless the method sending req already chose a media type.
+func setBodyFormat(ctx context.Context, req *http.Request) {
+	f, ok := ctx.Value(bodyFormatKey{}).(BodyFormat)
+	if !ok || f == "" || req.Header.Get("Accept") != "" {
+		return
+	}
+	// req shares its Header with the request of the caller.
+	req.Header = req.Header.Clone()
+	req.Header.Set("Accept", mimeV3+string(f)+"+json")
+}
+
+// A RawType selects a raw representation of a pull request or commit, as
+// returned by PullRequestsService.GetRaw and RepositoriesService.GetCommitRaw.
+//
+// GitHub API docs: http://developer.github.com/v3/media/#commits-commit-comparison-and-pull-requests
+type RawType string
+
+const (
+	// Diff is the unified diff of the changes.
+	Diff RawType = "diff"
+
+	// Patch is the changes formatted as a series of emails, as by
+	// git format-patch.
+	Patch RawType = "patch"
+)
+
+// mediaType returns the media type to accept for t.
+func (t RawType) mediaType() string {
+	return mimeV3 + string(t)
+}
+
+// getRaw gets the representation t of the resource at urlStr.
+func (c *Client) getRaw(ctx context.Context, urlStr string, t RawType) ([]byte, *Response, error) {
+	if t != Diff && t != Patch {
+		return nil, nil, fmt.Errorf("github: unsupported raw type %q", t)
+	}
+
+	req, err := c.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", t.mediaType())
+
+	buf := new(bytes.Buffer)
+	resp, err := c.Do(ctx, req, buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return buf.Bytes(), resp, nil
+}
+
+// isRedirect reports whether status is one GitHub redirects downloads with.
+func isRedirect(status int) bool {
+	switch status {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
+
+// download sends req without following redirects, and returns a reader for
+// the body of the response.  If GitHub redirects to the content, as it does
+// for release assets and archives, the new location is fetched through the
+// HTTP client of c, whose authenticating transports do not send credentials
+// to the storage host.  The returned Response is the one from GitHub.
+func (c *Client) download(ctx context.Context, req *http.Request) (io.ReadCloser, *Response, error) {
+	resp, err := c.DoStream(context.WithValue(ctx, noRedirectKey{}, true), req)
+	if resp == nil {
+		return nil, nil, err
+	}
+	if !isRedirect(resp.StatusCode) {
+		if err != nil {
+			return nil, resp, err
+		}
+		return resp.Body, resp, nil
+	}
+
+	// CheckResponse has read and closed the body of the redirect.
+	loc, err := resp.Response.Location()
+	if err != nil {
+		return nil, resp, err
+	}
+	dreq, err := http.NewRequest("GET", loc.String(), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	dresp, err := c.client.Do(dreq.WithContext(c.withRequestInfo(ctx, dreq)))
+	if err != nil {
+		return nil, resp, err
+	}
+	if dresp.StatusCode != http.StatusOK {
+		dresp.Body.Close()
+		return nil, resp, fmt.Errorf("github: downloading from %v: %v", loc.Host, dresp.Status)
+	}
+	return dresp.Body, resp, nil
+}
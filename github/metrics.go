@@ -0,0 +1,209 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds of the request latency histogram
+// buckets used by Counters when Buckets is nil.
+var DefaultLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Counters is a MetricsRecorder that keeps Prometheus-style metrics in
+// memory, to be used with MetricsMiddleware:
+//
+//	github_requests_total{method,endpoint,code}             counter
+//	github_request_duration_seconds{method,endpoint}        histogram
+//	github_rate_limit{category}, github_rate_remaining{...} gauges
+//	github_rate_reset_timestamp_seconds{category}           gauge
+//
+// Counters implements http.Handler, serving the metrics in the Prometheus
+// text exposition format, so it can be scraped directly:
+//
+//	counters := new(github.Counters)
+//	client.Middleware = append(client.Middleware, github.MetricsMiddleware(counters))
+//	http.Handle("/metrics", counters)
+//
+// The zero value is ready to use.
+type Counters struct {
+	// Buckets are the upper bounds of the latency histogram buckets, in
+	// increasing order.  If nil, DefaultLatencyBuckets is used.  Buckets must
+	// not be changed once requests have been observed.
+	Buckets []time.Duration
+
+	mu       sync.Mutex
+	requests map[requestLabels]int64
+	latency  map[endpointLabels]*histogram
+	rates    map[string]Rate
+}
+
+type endpointLabels struct {
+	method, endpoint string
+}
+
+type requestLabels struct {
+	endpointLabels
+	code int
+}
+
+// histogram counts observations per bucket; counts[i] holds those no greater
+// than bucket i, and the last element those greater than every bucket.
+type histogram struct {
+	counts []int64
+	sum    time.Duration
+}
+
+// ObserveRequest implements the MetricsRecorder interface.
+func (c *Counters) ObserveRequest(method, endpoint string, status int, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.requests == nil {
+		c.requests = make(map[requestLabels]int64)
+		c.latency = make(map[endpointLabels]*histogram)
+	}
+	e := endpointLabels{method, endpoint}
+	c.requests[requestLabels{e, status}]++
+
+	buckets := c.buckets()
+	h := c.latency[e]
+	if h == nil {
+		h = &histogram{counts: make([]int64, len(buckets)+1)}
+		c.latency[e] = h
+	}
+	i := sort.Search(len(buckets), func(i int) bool { return d <= buckets[i] })
+	h.counts[i]++
+	h.sum += d
+}
+
+// SetRate implements the MetricsRecorder interface.
+func (c *Counters) SetRate(category string, rate Rate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.rates == nil {
+		c.rates = make(map[string]Rate)
+	}
+	c.rates[category] = rate
+}
+
+func (c *Counters) buckets() []time.Duration {
+	if c.Buckets != nil {
+		return c.Buckets
+	}
+	return DefaultLatencyBuckets
+}
+
+// WriteTo writes the metrics to w in the Prometheus text exposition format.
+// Series are sorted by their labels, so the output is stable.
+func (c *Counters) WriteTo(w io.Writer) (int64, error) {
+	buf := new(bytes.Buffer)
+	c.mu.Lock()
+
+	requests := make([]requestLabels, 0, len(c.requests))
+	for k := range c.requests {
+		requests = append(requests, k)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		a, b := requests[i], requests[j]
+		if a.endpointLabels != b.endpointLabels {
+			return a.endpointLabels.less(b.endpointLabels)
+		}
+		return a.code < b.code
+	})
+	fmt.Fprintln(buf, "# HELP github_requests_total GitHub API requests by endpoint and status code.")
+	fmt.Fprintln(buf, "# TYPE github_requests_total counter")
+	for _, k := range requests {
+		fmt.Fprintf(buf, "github_requests_total{%v,code=\"%d\"} %d\n", k.endpointLabels, k.code, c.requests[k])
+	}
+
+	endpoints := make([]endpointLabels, 0, len(c.latency))
+	for k := range c.latency {
+		endpoints = append(endpoints, k)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].less(endpoints[j]) })
+	fmt.Fprintln(buf, "# HELP github_request_duration_seconds GitHub API request latency by endpoint.")
+	fmt.Fprintln(buf, "# TYPE github_request_duration_seconds histogram")
+	buckets := c.buckets()
+	for _, k := range endpoints {
+		h := c.latency[k]
+		var n int64
+		for i, b := range buckets {
+			n += h.counts[i]
+			fmt.Fprintf(buf, "github_request_duration_seconds_bucket{%v,le=\"%v\"} %d\n", k, b.Seconds(), n)
+		}
+		n += h.counts[len(buckets)]
+		fmt.Fprintf(buf, "github_request_duration_seconds_bucket{%v,le=\"+Inf\"} %d\n", k, n)
+		fmt.Fprintf(buf, "github_request_duration_seconds_sum{%v} %v\n", k, h.sum.Seconds())
+		fmt.Fprintf(buf, "github_request_duration_seconds_count{%v} %d\n", k, n)
+	}
+
+	categories := make([]string, 0, len(c.rates))
+	for k := range c.rates {
+		categories = append(categories, k)
+	}
+	sort.Strings(categories)
+	gauges := []struct {
+		name, help string
+		value      func(Rate) int64
+	}{
+		{"github_rate_limit", "GitHub API rate limit by category.", func(r Rate) int64 { return int64(r.Limit) }},
+		{"github_rate_remaining", "GitHub API requests remaining before the rate limit resets.", func(r Rate) int64 { return int64(r.Remaining) }},
+		{"github_rate_reset_timestamp_seconds", "Time at which the GitHub API rate limit resets.", func(r Rate) int64 { return r.Reset.Unix() }},
+	}
+	for _, g := range gauges {
+		fmt.Fprintf(buf, "# HELP %v %v\n", g.name, g.help)
+		fmt.Fprintf(buf, "# TYPE %v gauge\n", g.name)
+		for _, k := range categories {
+			fmt.Fprintf(buf, "%v{category=\"%v\"} %d\n", g.name, escapeLabel(k), g.value(c.rates[k]))
+		}
+	}
+
+	c.mu.Unlock()
+	return buf.WriteTo(w)
+}
+
+// ServeHTTP serves the metrics in the Prometheus text exposition format.
+func (c *Counters) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	c.WriteTo(w)
+}
+
+func (e endpointLabels) less(o endpointLabels) bool {
+	if e.endpoint != o.endpoint {
+		return e.endpoint < o.endpoint
+	}
+	return e.method < o.method
+}
+
+// String formats e as Prometheus labels, without the enclosing braces.
+func (e endpointLabels) String() string {
+	return fmt.Sprintf("method=\"%v\",endpoint=\"%v\"", escapeLabel(e.method), escapeLabel(e.endpoint))
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabel escapes a Prometheus label value.
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
@@ -0,0 +1,60 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCounters(t *testing.T) {
+	c := &Counters{Buckets: []time.Duration{100 * time.Millisecond, time.Second}}
+	c.ObserveRequest("GET", "users/:user", 200, 50*time.Millisecond)
+	c.ObserveRequest("GET", "users/:user", 200, 500*time.Millisecond)
+	c.ObserveRequest("GET", "users/:user", 404, 2*time.Second)
+	c.ObserveRequest("POST", `odd"path`, 0, time.Millisecond)
+	c.SetRate("core", Rate{Limit: 5000, Remaining: 4997, Reset: time.Unix(1372700873, 0)})
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, &http.Request{})
+
+	want := `# HELP github_requests_total GitHub API requests by endpoint and status code.
+# TYPE github_requests_total counter
+github_requests_total{method="POST",endpoint="odd\"path",code="0"} 1
+github_requests_total{method="GET",endpoint="users/:user",code="200"} 2
+github_requests_total{method="GET",endpoint="users/:user",code="404"} 1
+# HELP github_request_duration_seconds GitHub API request latency by endpoint.
+# TYPE github_request_duration_seconds histogram
+github_request_duration_seconds_bucket{method="POST",endpoint="odd\"path",le="0.1"} 1
+github_request_duration_seconds_bucket{method="POST",endpoint="odd\"path",le="1"} 1
+github_request_duration_seconds_bucket{method="POST",endpoint="odd\"path",le="+Inf"} 1
+github_request_duration_seconds_sum{method="POST",endpoint="odd\"path"} 0.001
+github_request_duration_seconds_count{method="POST",endpoint="odd\"path"} 1
+github_request_duration_seconds_bucket{method="GET",endpoint="users/:user",le="0.1"} 1
+github_request_duration_seconds_bucket{method="GET",endpoint="users/:user",le="1"} 2
+github_request_duration_seconds_bucket{method="GET",endpoint="users/:user",le="+Inf"} 3
+github_request_duration_seconds_sum{method="GET",endpoint="users/:user"} 2.55
+github_request_duration_seconds_count{method="GET",endpoint="users/:user"} 3
+# HELP github_rate_limit GitHub API rate limit by category.
+# TYPE github_rate_limit gauge
+github_rate_limit{category="core"} 5000
+# HELP github_rate_remaining GitHub API requests remaining before the rate limit resets.
+# TYPE github_rate_remaining gauge
+github_rate_remaining{category="core"} 4997
+# HELP github_rate_reset_timestamp_seconds Time at which the GitHub API rate limit resets.
+# TYPE github_rate_reset_timestamp_seconds gauge
+github_rate_reset_timestamp_seconds{category="core"} 1372700873
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("Counters output:\n%v\nwant:\n%v", got, want)
+	}
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain") {
+		t.Errorf("Content-Type = %v, want text/plain", got)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RoundTripFunc sends a single HTTP request and returns its response.  It has
+// the same contract as http.RoundTripper: it must not modify req, and must
+// return either a response or an error.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware intercepts the requests sent by a Client.  It is given the next
+// step of the chain, and returns a RoundTripFunc that typically inspects the
+// request, calls next, and inspects the response.  The request context holds
+// the endpoint of the request; see Endpoint.
+//
+// Middleware sees each request as it is sent over the wire, so a request that
+// is retried passes through it once per attempt, and a conditional request
+// answered from the cache passes through it with its 304 Not Modified
+// response.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// roundTripper returns the RoundTripFunc that sends requests through
+// c.Middleware.  The first middleware is the outermost one.
+func (c *Client) roundTripper() RoundTripFunc {
+	rt := RoundTripFunc(c.sendHTTP)
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		rt = c.Middleware[i](rt)
+	}
+	return rt
+}
+
+// noRedirectKey is the context key marking requests whose redirects are
+// returned to the caller rather than followed.
+type noRedirectKey struct{}
+
+// sendHTTP sends req with the HTTP client of c.  Redirects are not followed if
+// the request context holds noRedirectKey.
+func (c *Client) sendHTTP(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(noRedirectKey{}) == nil {
+		return c.client.Do(req)
+	}
+	hc := *c.client
+	hc.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return hc.Do(req)
+}
+
+// requestInfo describes a request being sent by a Client.  It is stored in
+// the request context.
+type requestInfo struct {
+	endpoint string
+	category rateCategory
+
+	// hosts are the API and uploads hosts of the Client, the only ones
+	// authenticating transports send credentials to.
+	hosts []string
+}
+
+type requestInfoKey struct{}
+
+// withRequestInfo returns a copy of ctx holding the requestInfo of req.
+func (c *Client) withRequestInfo(ctx context.Context, req *http.Request) context.Context {
+	path := req.URL.Path
+	var hosts []string
+	for _, base := range []*url.URL{c.BaseURL, c.UploadURL} {
+		if base == nil {
+			continue
+		}
+		hosts = append(hosts, base.Host)
+		if path == req.URL.Path && strings.HasPrefix(path, base.Path) {
+			path = path[len(base.Path):]
+		}
+	}
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{
+		endpoint: endpointTemplate(path),
+		category: c.rateCategory(req),
+		hosts:    hosts,
+	})
+}
+
+// Endpoint returns the endpoint template of a request sent by a Client, such
+// as "repos/:owner/:repo/hooks/:id", for use by middleware.  Path parameters
+// are replaced by their names, so that requests for different resources of the
+// same kind share a template.  An empty string is returned for requests not
+// sent through Client.Do.
+//
+// Templates are derived from the request path, and paths the library does
+// not recognize keep their segments as-is.
+func Endpoint(req *http.Request) string {
+	if info, ok := req.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+		return info.endpoint
+	}
+	return ""
+}
+
+// Path segments that name a resource following a collection, by the name of
+// the collection.
+var (
+	// Collections followed by the owner and name of a repository.
+	repoParams = map[string]bool{
+		"networks": true, "repos": true, "starred": true, "subscriptions": true,
+	}
+
+	// Collections followed by a single named parameter.
+	namedParams = map[string]string{
+		"assignees":      ":user",
+		"blobs":          ":sha",
+		"branches":       ":branch",
+		"collaborators":  ":user",
+		"commits":        ":sha",
+		"compare":        ":basehead",
+		"following":      ":user",
+		"labels":         ":name",
+		"members":        ":user",
+		"orgs":           ":org",
+		"public_members": ":user",
+		"statuses":       ":ref",
+		"tags":           ":tag",
+		"trees":          ":sha",
+		"users":          ":user",
+	}
+
+	// Collections followed by a parameter spanning all remaining segments.
+	restParams = map[string]string{
+		"contents": ":path",
+		"refs":     ":ref",
+	}
+
+	// Collections whose numeric members are named :number rather than :id.
+	numberParams = map[string]bool{
+		"issues": true, "milestones": true, "pulls": true,
+	}
+)
+
+// endpointTemplate returns the template of an API path relative to the base
+// URL, such as "repos/:owner/:repo/issues/:number" for "repos/o/r/issues/1".
+func endpointTemplate(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments); i++ {
+		s := segments[i]
+		last := i == len(segments)-1
+		switch {
+		case repoParams[s] && i+2 < len(segments):
+			segments[i+1], segments[i+2] = ":owner", ":repo"
+			i += 2
+		case namedParams[s] != "" && !last:
+			segments[i+1] = namedParams[s]
+			i++
+		case restParams[s] != "" && !last:
+			segments = append(segments[:i+1], restParams[s])
+		case s == "gists" && !last && segments[i+1] != "public" && segments[i+1] != "starred":
+			segments[i+1] = ":id"
+			i++
+		case isNumber(s):
+			if i > 0 && numberParams[segments[i-1]] {
+				segments[i] = ":number"
+			} else {
+				segments[i] = ":id"
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isNumber reports whether s consists only of decimal digits.
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// LoggingMiddleware returns a Middleware that logs every request and its
+// outcome with logf, which can be log.Printf.  Each request is logged as one
+// line of key=value pairs:
+//
+//	github: method=GET endpoint=repos/:owner/:repo url=https://api.github.com/repos/o/r status=200 duration=85ms rate_remaining=4999
+//
+// Credentials are never logged: the Authorization header is left out, and the
+// values of the client_secret and access_token query parameters are replaced
+// with "REDACTED".
+func LoggingMiddleware(logf func(format string, v ...interface{})) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			d := time.Since(start)
+
+			line := fmt.Sprintf("github: method=%v endpoint=%v url=%v", req.Method, Endpoint(req), redactURL(req.URL))
+			if err != nil {
+				logf("%v error=%q duration=%v", line, err.Error(), d)
+			} else {
+				line = fmt.Sprintf("%v status=%d duration=%v", line, resp.StatusCode, d)
+				if remaining := resp.Header.Get(headerRateRemaining); remaining != "" {
+					line += " rate_remaining=" + remaining
+				}
+				logf("%v", line)
+			}
+			return resp, err
+		}
+	}
+}
+
+// redactedParams are the query parameters whose values are credentials.
+var redactedParams = []string{"access_token", "client_secret"}
+
+// redactURL returns u as a string, with the values of credential query
+// parameters replaced.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	redacted := false
+	for _, p := range redactedParams {
+		if _, ok := q[p]; ok {
+			q.Set(p, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+
+	r := *u
+	r.RawQuery = q.Encode()
+	return r.String()
+}
+
+// MetricsRecorder receives the measurements taken by MetricsMiddleware.
+// Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest records a request to an endpoint (see Endpoint) that
+	// completed with the given status code after d.  The status code is 0 if
+	// the request failed without a response.
+	ObserveRequest(method, endpoint string, status int, d time.Duration)
+
+	// SetRate records the rate limit of a category of requests, "core" or
+	// "search", as reported by a response.
+	SetRate(category string, rate Rate)
+}
+
+// MetricsMiddleware returns a Middleware that reports the latency and outcome
+// of every request, and the rate limits reported by the responses, to m.
+// Counters is a MetricsRecorder exposing them in the Prometheus text format.
+func MetricsMiddleware(m MetricsRecorder) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			d := time.Since(start)
+
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+				if rate := parseRate(resp.Header); rate.Limit != 0 {
+					category := coreCategory
+					if info, ok := req.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+						category = info.category
+					}
+					m.SetRate(category.String(), rate)
+				}
+			}
+			m.ObserveRequest(req.Method, Endpoint(req), status, d)
+			return resp, err
+		}
+	}
+}
+
+// String returns the name of the category, as used in the rate limit API.
+func (c rateCategory) String() string {
+	if c == searchCategory {
+		return "search"
+	}
+	return "core"
+}
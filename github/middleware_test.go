@@ -0,0 +1,216 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDo_middleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Test", "outer,inner")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	var calls []string
+	mw := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" "+Endpoint(req))
+				req = cloneRequest(req)
+				req.Header.Set("X-Test", strings.TrimPrefix(req.Header.Get("X-Test")+","+name, ","))
+				resp, err := next(req)
+				calls = append(calls, name+" done")
+				return resp, err
+			}
+		}
+	}
+	client.Middleware = []Middleware{mw("outer"), mw("inner")}
+
+	hook, _, err := client.Repositories.GetHook(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("GetHook returned error: %v", err)
+	}
+	if want := (&Hook{ID: Int(1)}); !reflect.DeepEqual(hook, want) {
+		t.Errorf("GetHook returned %+v, want %+v", hook, want)
+	}
+
+	want := []string{
+		"outer repos/:owner/:repo/hooks/:id",
+		"inner repos/:owner/:repo/hooks/:id",
+		"inner done",
+		"outer done",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Middleware calls = %q, want %q", calls, want)
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{"", ""},
+		{"user", "user"},
+		{"rate_limit", "rate_limit"},
+		{"users/u", "users/:user"},
+		{"users/u/events/orgs/o", "users/:user/events/orgs/:org"},
+		{"orgs/o/public_members/u", "orgs/:org/public_members/:user"},
+		{"repos/o/r", "repos/:owner/:repo"},
+		{"repos/o/r/hooks", "repos/:owner/:repo/hooks"},
+		{"repos/o/r/hooks/1/tests", "repos/:owner/:repo/hooks/:id/tests"},
+		{"repos/o/r/issues/5/labels/bug", "repos/:owner/:repo/issues/:number/labels/:name"},
+		{"repos/o/r/issues/comments/7", "repos/:owner/:repo/issues/comments/:id"},
+		{"repos/o/r/pulls/3/comments", "repos/:owner/:repo/pulls/:number/comments"},
+		{"repos/o/r/git/trees/abc123", "repos/:owner/:repo/git/trees/:sha"},
+		{"repos/o/r/git/refs/heads/feature/x", "repos/:owner/:repo/git/refs/:ref"},
+		{"repos/o/r/contents/dir/file.go", "repos/:owner/:repo/contents/:path"},
+		{"teams/1/repos/o/r", "teams/:id/repos/:owner/:repo"},
+		{"user/starred/o/r", "user/starred/:owner/:repo"},
+		{"gists/public", "gists/public"},
+		{"gists/aa5a315d61ae9438b18d/star", "gists/:id/star"},
+		{"search/repositories", "search/repositories"},
+	}
+
+	for _, tt := range tests {
+		if got := endpointTemplate(tt.path); got != tt.want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestEndpoint_enterprise(t *testing.T) {
+	c, _ := NewEnterpriseClient("https://ghe.example.com", "", nil)
+
+	var endpoint string
+	c.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			endpoint = Endpoint(req)
+			return nil, fmt.Errorf("not sent")
+		}
+	}}
+
+	req, _ := c.NewRequest("GET", "repos/o/r", nil)
+	c.Do(context.Background(), req, nil)
+
+	if want := "repos/:owner/:repo"; endpoint != want {
+		t.Errorf("Endpoint = %q, want %q", endpoint, want)
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateRemaining, "42")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var lines []string
+	client.Middleware = []Middleware{LoggingMiddleware(func(format string, v ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, v...))
+	})}
+
+	req, _ := client.NewRequest("GET", "users/u?client_id=id&client_secret=secret&access_token=token", nil)
+	req.Header.Set("Authorization", "token token")
+	client.Do(context.Background(), req, nil)
+
+	if len(lines) != 1 {
+		t.Fatalf("Logged %d lines, want 1: %q", len(lines), lines)
+	}
+	line := lines[0]
+	for _, want := range []string{
+		"method=GET",
+		"endpoint=users/:user ",
+		"client_id=id",
+		"client_secret=REDACTED",
+		"access_token=REDACTED",
+		"status=404",
+		"rate_remaining=42",
+	} {
+		if !strings.Contains(line, want) {
+			t.Errorf("Logged line %q does not contain %q", line, want)
+		}
+	}
+	if strings.Contains(line, "secret=secret") || strings.Contains(line, "token=token") || strings.Contains(line, "token token") {
+		t.Errorf("Logged line %q contains credentials", line)
+	}
+}
+
+func TestLoggingMiddleware_error(t *testing.T) {
+	var line string
+	mw := LoggingMiddleware(func(format string, v ...interface{}) {
+		line = fmt.Sprintf(format, v...)
+	})
+	rt := mw(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+
+	u, _ := url.Parse("https://api.github.com/users/u")
+	rt(&http.Request{Method: "GET", URL: u, Header: make(http.Header)})
+
+	if want := `error="connection refused"`; !strings.Contains(line, want) {
+		t.Errorf("Logged line %q does not contain %q", line, want)
+	}
+}
+
+type testRecorder struct {
+	requests []string
+	rates    map[string]Rate
+}
+
+func (r *testRecorder) ObserveRequest(method, endpoint string, status int, d time.Duration) {
+	r.requests = append(r.requests, fmt.Sprintf("%v %v %d", method, endpoint, status))
+}
+
+func (r *testRecorder) SetRate(category string, rate Rate) {
+	r.rates[category] = rate
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "30")
+		w.Header().Set(headerRateRemaining, "29")
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		fmt.Fprint(w, `{}`)
+	})
+
+	m := &testRecorder{rates: make(map[string]Rate)}
+	client.Middleware = []Middleware{MetricsMiddleware(m)}
+
+	client.Search.Issues(context.Background(), "q", nil)
+	client.Issues.Get(context.Background(), "o", "r", 1)
+
+	wantRequests := []string{"GET search/issues 200", "GET repos/:owner/:repo/issues/:number 200"}
+	if !reflect.DeepEqual(m.requests, wantRequests) {
+		t.Errorf("Observed requests %q, want %q", m.requests, wantRequests)
+	}
+	wantRates := map[string]Rate{
+		"search": {Limit: 30, Remaining: 29},
+		"core":   {Limit: 5000, Remaining: 4999},
+	}
+	if !reflect.DeepEqual(m.rates, wantRates) {
+		t.Errorf("Recorded rates %+v, want %+v", m.rates, wantRates)
+	}
+}
@@ -6,9 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -38,18 +37,16 @@ func (o Organization) String() string {
 // organizations for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/#list-user-organizations
-func (s *OrganizationsService) List(user string, opt *ListOptions) ([]Organization, *Response, error) {
+func (s *OrganizationsService) List(ctx context.Context, user string, opt *ListOptions) ([]Organization, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/orgs", user)
 	} else {
 		u = "user/orgs"
 	}
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -58,14 +55,14 @@ func (s *OrganizationsService) List(user string, opt *ListOptions) ([]Organizati
 	}
 
 	orgs := new([]Organization)
-	resp, err := s.client.Do(req, orgs)
+	resp, err := s.client.Do(ctx, req, orgs)
 	return *orgs, resp, err
 }
 
 // Get fetches an organization by name.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/#get-an-organization
-func (s *OrganizationsService) Get(org string) (*Organization, *Response, error) {
+func (s *OrganizationsService) Get(ctx context.Context, org string) (*Organization, *Response, error) {
 	u := fmt.Sprintf("orgs/%v", org)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -73,14 +70,14 @@ func (s *OrganizationsService) Get(org string) (*Organization, *Response, error)
 	}
 
 	organization := new(Organization)
-	resp, err := s.client.Do(req, organization)
+	resp, err := s.client.Do(ctx, req, organization)
 	return organization, resp, err
 }
 
 // Edit an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/#edit-an-organization
-func (s *OrganizationsService) Edit(name string, org *Organization) (*Organization, *Response, error) {
+func (s *OrganizationsService) Edit(ctx context.Context, name string, org *Organization) (*Organization, *Response, error) {
 	u := fmt.Sprintf("orgs/%v", name)
 	req, err := s.client.NewRequest("PATCH", u, org)
 	if err != nil {
@@ -88,6 +85,6 @@ func (s *OrganizationsService) Edit(name string, org *Organization) (*Organizati
 	}
 
 	o := new(Organization)
-	resp, err := s.client.Do(req, o)
+	resp, err := s.client.Do(ctx, req, o)
 	return o, resp, err
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -18,10 +19,12 @@ func TestOrganizationsService_ListMembers(t *testing.T) {
 
 	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	members, _, err := client.Organizations.ListMembers("o", false)
+	opt := &ListOptions{Page: 2}
+	members, _, err := client.Organizations.ListMembers(context.Background(), "o", false, opt)
 	if err != nil {
 		t.Errorf("Organizations.ListMembers returned error: %v", err)
 	}
@@ -33,7 +36,7 @@ func TestOrganizationsService_ListMembers(t *testing.T) {
 }
 
 func TestOrganizationsService_ListMembers_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.ListMembers("%", false)
+	_, _, err := client.Organizations.ListMembers(context.Background(), "%", false, nil)
 	testURLParseError(t, err)
 }
 
@@ -43,10 +46,12 @@ func TestOrganizationsService_ListMembers_public(t *testing.T) {
 
 	mux.HandleFunc("/orgs/o/public_members", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	members, _, err := client.Organizations.ListMembers("o", true)
+	opt := &ListOptions{Page: 2}
+	members, _, err := client.Organizations.ListMembers(context.Background(), "o", true, opt)
 	if err != nil {
 		t.Errorf("Organizations.ListMembers returned error: %v", err)
 	}
@@ -66,7 +71,7 @@ func TestOrganizationsService_IsMember(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	member, _, err := client.Organizations.IsMember("o", "u")
+	member, _, err := client.Organizations.IsMember(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.IsMember returned error: %v", err)
 	}
@@ -85,7 +90,7 @@ func TestOrganizationsService_IsMember_notMember(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	member, _, err := client.Organizations.IsMember("o", "u")
+	member, _, err := client.Organizations.IsMember(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.IsMember returned error: %+v", err)
 	}
@@ -105,7 +110,7 @@ func TestOrganizationsService_IsMember_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	member, _, err := client.Organizations.IsMember("o", "u")
+	member, _, err := client.Organizations.IsMember(context.Background(), "o", "u")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -115,7 +120,7 @@ func TestOrganizationsService_IsMember_error(t *testing.T) {
 }
 
 func TestOrganizationsService_IsMember_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.IsMember("%", "u")
+	_, _, err := client.Organizations.IsMember(context.Background(), "%", "u")
 	testURLParseError(t, err)
 }
 
@@ -128,7 +133,7 @@ func TestOrganizationsService_IsPublicMember(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	member, _, err := client.Organizations.IsPublicMember("o", "u")
+	member, _, err := client.Organizations.IsPublicMember(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.IsPublicMember returned error: %v", err)
 	}
@@ -147,7 +152,7 @@ func TestOrganizationsService_IsPublicMember_notMember(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	member, _, err := client.Organizations.IsPublicMember("o", "u")
+	member, _, err := client.Organizations.IsPublicMember(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.IsPublicMember returned error: %v", err)
 	}
@@ -167,7 +172,7 @@ func TestOrganizationsService_IsPublicMember_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	member, _, err := client.Organizations.IsPublicMember("o", "u")
+	member, _, err := client.Organizations.IsPublicMember(context.Background(), "o", "u")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -177,7 +182,7 @@ func TestOrganizationsService_IsPublicMember_error(t *testing.T) {
 }
 
 func TestOrganizationsService_IsPublicMember_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.IsPublicMember("%", "u")
+	_, _, err := client.Organizations.IsPublicMember(context.Background(), "%", "u")
 	testURLParseError(t, err)
 }
 
@@ -189,13 +194,13 @@ func TestOrganizationsService_RemoveMember(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Organizations.RemoveMember("o", "u")
+	_, err := client.Organizations.RemoveMember(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.RemoveMember returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_RemoveMember_invalidOrg(t *testing.T) {
-	_, err := client.Organizations.RemoveMember("%", "u")
+	_, err := client.Organizations.RemoveMember(context.Background(), "%", "u")
 	testURLParseError(t, err)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestOrganizationsService_ListTeams(t *testing.T) {
 
 	mux.HandleFunc("/orgs/o/teams", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	teams, _, err := client.Organizations.ListTeams("o")
+	opt := &ListOptions{Page: 2}
+	teams, _, err := client.Organizations.ListTeams(context.Background(), "o", opt)
 	if err != nil {
 		t.Errorf("Organizations.ListTeams returned error: %v", err)
 	}
@@ -34,7 +37,7 @@ func TestOrganizationsService_ListTeams(t *testing.T) {
 }
 
 func TestOrganizationsService_ListTeams_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.ListTeams("%")
+	_, _, err := client.Organizations.ListTeams(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -47,7 +50,7 @@ func TestOrganizationsService_GetTeam(t *testing.T) {
 		fmt.Fprint(w, `{"id":1, "name":"n", "url":"u", "slug": "s", "permission":"p"}`)
 	})
 
-	team, _, err := client.Organizations.GetTeam(1)
+	team, _, err := client.Organizations.GetTeam(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Organizations.GetTeam returned error: %v", err)
 	}
@@ -76,7 +79,7 @@ func TestOrganizationsService_CreateTeam(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	team, _, err := client.Organizations.CreateTeam("o", input)
+	team, _, err := client.Organizations.CreateTeam(context.Background(), "o", input)
 	if err != nil {
 		t.Errorf("Organizations.CreateTeam returned error: %v", err)
 	}
@@ -88,7 +91,7 @@ func TestOrganizationsService_CreateTeam(t *testing.T) {
 }
 
 func TestOrganizationsService_CreateTeam_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.CreateTeam("%", nil)
+	_, _, err := client.Organizations.CreateTeam(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -110,7 +113,7 @@ func TestOrganizationsService_EditTeam(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	team, _, err := client.Organizations.EditTeam(1, input)
+	team, _, err := client.Organizations.EditTeam(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Organizations.EditTeam returned error: %v", err)
 	}
@@ -129,7 +132,7 @@ func TestOrganizationsService_DeleteTeam(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Organizations.DeleteTeam(1)
+	_, err := client.Organizations.DeleteTeam(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Organizations.DeleteTeam returned error: %v", err)
 	}
@@ -141,10 +144,12 @@ func TestOrganizationsService_ListTeamMembers(t *testing.T) {
 
 	mux.HandleFunc("/teams/1/members", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	members, _, err := client.Organizations.ListTeamMembers(1)
+	opt := &ListOptions{Page: 2}
+	members, _, err := client.Organizations.ListTeamMembers(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Organizations.ListTeamMembers returned error: %v", err)
 	}
@@ -163,7 +168,7 @@ func TestOrganizationsService_IsTeamMember_true(t *testing.T) {
 		testMethod(t, r, "GET")
 	})
 
-	member, _, err := client.Organizations.IsTeamMember(1, "u")
+	member, _, err := client.Organizations.IsTeamMember(context.Background(), 1, "u")
 	if err != nil {
 		t.Errorf("Organizations.IsTeamMember returned error: %v", err)
 	}
@@ -182,7 +187,7 @@ func TestOrganizationsService_IsTeamMember_false(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	member, _, err := client.Organizations.IsTeamMember(1, "u")
+	member, _, err := client.Organizations.IsTeamMember(context.Background(), 1, "u")
 	if err != nil {
 		t.Errorf("Organizations.IsTeamMember returned error: %+v", err)
 	}
@@ -202,7 +207,7 @@ func TestOrganizationsService_IsTeamMember_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	member, _, err := client.Organizations.IsTeamMember(1, "u")
+	member, _, err := client.Organizations.IsTeamMember(context.Background(), 1, "u")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -212,7 +217,7 @@ func TestOrganizationsService_IsTeamMember_error(t *testing.T) {
 }
 
 func TestOrganizationsService_IsTeamMember_invalidUser(t *testing.T) {
-	_, _, err := client.Organizations.IsTeamMember(1, "%")
+	_, _, err := client.Organizations.IsTeamMember(context.Background(), 1, "%")
 	testURLParseError(t, err)
 }
 
@@ -225,14 +230,14 @@ func TestOrganizationsService_AddTeamMember(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.AddTeamMember(1, "u")
+	_, err := client.Organizations.AddTeamMember(context.Background(), 1, "u")
 	if err != nil {
 		t.Errorf("Organizations.AddTeamMember returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_AddTeamMember_invalidUser(t *testing.T) {
-	_, err := client.Organizations.AddTeamMember(1, "%")
+	_, err := client.Organizations.AddTeamMember(context.Background(), 1, "%")
 	testURLParseError(t, err)
 }
 
@@ -245,14 +250,14 @@ func TestOrganizationsService_RemoveTeamMember(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.RemoveTeamMember(1, "u")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), 1, "u")
 	if err != nil {
 		t.Errorf("Organizations.RemoveTeamMember returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_RemoveTeamMember_invalidUser(t *testing.T) {
-	_, err := client.Organizations.RemoveTeamMember(1, "%")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), 1, "%")
 	testURLParseError(t, err)
 }
 
@@ -265,14 +270,14 @@ func TestOrganizationsService_PublicizeMembership(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.PublicizeMembership("o", "u")
+	_, err := client.Organizations.PublicizeMembership(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.PublicizeMembership returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_PublicizeMembership_invalidOrg(t *testing.T) {
-	_, err := client.Organizations.PublicizeMembership("%", "u")
+	_, err := client.Organizations.PublicizeMembership(context.Background(), "%", "u")
 	testURLParseError(t, err)
 }
 
@@ -285,14 +290,14 @@ func TestOrganizationsService_ConcealMembership(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.ConcealMembership("o", "u")
+	_, err := client.Organizations.ConcealMembership(context.Background(), "o", "u")
 	if err != nil {
 		t.Errorf("Organizations.ConcealMembership returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_ConcealMembership_invalidOrg(t *testing.T) {
-	_, err := client.Organizations.ConcealMembership("%", "u")
+	_, err := client.Organizations.ConcealMembership(context.Background(), "%", "u")
 	testURLParseError(t, err)
 }
 
@@ -302,10 +307,12 @@ func TestOrganizationsService_ListTeamRepos(t *testing.T) {
 
 	mux.HandleFunc("/teams/1/repos", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	members, _, err := client.Organizations.ListTeamRepos(1)
+	opt := &ListOptions{Page: 2}
+	members, _, err := client.Organizations.ListTeamRepos(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Organizations.ListTeamRepos returned error: %v", err)
 	}
@@ -325,7 +332,7 @@ func TestOrganizationsService_IsTeamRepo_true(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	managed, _, err := client.Organizations.IsTeamRepo(1, "o", "r")
+	managed, _, err := client.Organizations.IsTeamRepo(context.Background(), 1, "o", "r")
 	if err != nil {
 		t.Errorf("Organizations.IsTeamRepo returned error: %v", err)
 	}
@@ -343,7 +350,7 @@ func TestOrganizationsService_IsTeamRepo_false(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	managed, _, err := client.Organizations.IsTeamRepo(1, "o", "r")
+	managed, _, err := client.Organizations.IsTeamRepo(context.Background(), 1, "o", "r")
 	if err != nil {
 		t.Errorf("Organizations.IsTeamRepo returned error: %v", err)
 	}
@@ -361,7 +368,7 @@ func TestOrganizationsService_IsTeamRepo_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	managed, _, err := client.Organizations.IsTeamRepo(1, "o", "r")
+	managed, _, err := client.Organizations.IsTeamRepo(context.Background(), 1, "o", "r")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -371,7 +378,7 @@ func TestOrganizationsService_IsTeamRepo_error(t *testing.T) {
 }
 
 func TestOrganizationsService_IsTeamRepo_invalidOwner(t *testing.T) {
-	_, _, err := client.Organizations.IsTeamRepo(1, "%", "r")
+	_, _, err := client.Organizations.IsTeamRepo(context.Background(), 1, "%", "r")
 	testURLParseError(t, err)
 }
 
@@ -384,7 +391,7 @@ func TestOrganizationsService_AddTeamRepo(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.AddTeamRepo(1, "o", "r")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), 1, "o", "r")
 	if err != nil {
 		t.Errorf("Organizations.AddTeamRepo returned error: %v", err)
 	}
@@ -399,14 +406,14 @@ func TestOrganizationsService_AddTeamRepo_noAccess(t *testing.T) {
 		w.WriteHeader(422)
 	})
 
-	_, err := client.Organizations.AddTeamRepo(1, "o", "r")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), 1, "o", "r")
 	if err == nil {
 		t.Errorf("Expcted error to be returned")
 	}
 }
 
 func TestOrganizationsService_AddTeamRepo_invalidOwner(t *testing.T) {
-	_, err := client.Organizations.AddTeamRepo(1, "%", "r")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), 1, "%", "r")
 	testURLParseError(t, err)
 }
 
@@ -419,13 +426,13 @@ func TestOrganizationsService_RemoveTeamRepo(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Organizations.RemoveTeamRepo(1, "o", "r")
+	_, err := client.Organizations.RemoveTeamRepo(context.Background(), 1, "o", "r")
 	if err != nil {
 		t.Errorf("Organizations.RemoveTeamRepo returned error: %v", err)
 	}
 }
 
 func TestOrganizationsService_RemoveTeamRepo_invalidOwner(t *testing.T) {
-	_, err := client.Organizations.RemoveTeamRepo(1, "%", "r")
+	_, err := client.Organizations.RemoveTeamRepo(context.Background(), 1, "%", "r")
 	testURLParseError(t, err)
 }
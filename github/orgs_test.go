@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +23,7 @@ func TestOrganizationsService_List_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
 	})
 
-	orgs, _, err := client.Organizations.List("", nil)
+	orgs, _, err := client.Organizations.List(context.Background(), "", nil)
 	if err != nil {
 		t.Errorf("Organizations.List returned error: %v", err)
 	}
@@ -43,8 +44,8 @@ func TestOrganizationsService_List_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
 	})
 
-	opt := &ListOptions{2}
-	orgs, _, err := client.Organizations.List("u", opt)
+	opt := &ListOptions{Page: 2}
+	orgs, _, err := client.Organizations.List(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Organizations.List returned error: %v", err)
 	}
@@ -56,7 +57,7 @@ func TestOrganizationsService_List_specifiedUser(t *testing.T) {
 }
 
 func TestOrganizationsService_List_invalidUser(t *testing.T) {
-	_, _, err := client.Organizations.List("%", nil)
+	_, _, err := client.Organizations.List(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -69,7 +70,7 @@ func TestOrganizationsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"id":1, "login":"l", "url":"u", "avatar_url": "a", "location":"l"}`)
 	})
 
-	org, _, err := client.Organizations.Get("o")
+	org, _, err := client.Organizations.Get(context.Background(), "o")
 	if err != nil {
 		t.Errorf("Organizations.Get returned error: %v", err)
 	}
@@ -81,7 +82,7 @@ func TestOrganizationsService_Get(t *testing.T) {
 }
 
 func TestOrganizationsService_Get_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.Get("%")
+	_, _, err := client.Organizations.Get(context.Background(), "%")
 	testURLParseError(t, err)
 }
 
@@ -103,7 +104,7 @@ func TestOrganizationsService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	org, _, err := client.Organizations.Edit("o", input)
+	org, _, err := client.Organizations.Edit(context.Background(), "o", input)
 	if err != nil {
 		t.Errorf("Organizations.Edit returned error: %v", err)
 	}
@@ -115,6 +116,6 @@ func TestOrganizationsService_Edit(t *testing.T) {
 }
 
 func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
-	_, _, err := client.Organizations.Edit("%", nil)
+	_, _, err := client.Organizations.Edit(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
@@ -0,0 +1,109 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrStopPagination can be returned by the callback passed to Paginate to stop
+// walking pages early.  Paginate itself then returns nil.
+var ErrStopPagination = errors.New("github: stop pagination")
+
+// ListFunc fetches a single page of a paginated result set.  It is typically a
+// closure around one of the List methods on a service, copying the page values
+// in opt into that method's options.  The first return value must be a slice
+// holding the results on the page.
+type ListFunc func(ctx context.Context, opt *ListOptions) (interface{}, *Response, error)
+
+// PaginateOptions specifies the optional parameters to the Client.Paginate and
+// Client.PaginateAll methods.
+type PaginateOptions struct {
+	// ListOptions holds the page values used for the first request.  The page
+	// is advanced using Response.NextPage for every following request.
+	ListOptions
+
+	// MaxPages caps the number of pages fetched.  Zero means no limit.
+	MaxPages int
+}
+
+// Paginate walks every page of the result set returned by list, calling fn for
+// each result in order.  It stops after the last page (as reported by the Link
+// header of the response), after opt.MaxPages pages, when ctx is done, or when
+// fn or list returns an error.  If fn returns ErrStopPagination, or an error
+// wrapping it, Paginate returns nil; any other error is passed through as-is.
+//
+// For example, to print the type of every event for a repository:
+//
+//	list := func(ctx context.Context, opt *github.ListOptions) (interface{}, *github.Response, error) {
+//		return client.Activity.ListRepositoryEvents(ctx, "o", "r", opt)
+//	}
+//	err := client.Paginate(ctx, nil, list, func(v interface{}) error {
+//		fmt.Println(*v.(github.Event).Type)
+//		return nil
+//	})
+func (c *Client) Paginate(ctx context.Context, opt *PaginateOptions, list ListFunc, fn func(v interface{}) error) error {
+	var o PaginateOptions
+	if opt != nil {
+		o = *opt
+	}
+	page := o.ListOptions
+
+	for n := 0; o.MaxPages <= 0 || n < o.MaxPages; n++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		results, resp, err := list(ctx, &page)
+		if err != nil {
+			return err
+		}
+
+		v := reflect.ValueOf(results)
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("github: Paginate list function returned %T, want a slice", results)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := fn(v.Index(i).Interface()); err != nil {
+				if errors.Is(err, ErrStopPagination) {
+					return nil
+				}
+				return err
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page.Page = resp.NextPage
+	}
+	return nil
+}
+
+// PaginateAll walks the pages of the result set returned by list in the same
+// way as Paginate, and appends every result to the slice pointed to by dst.
+// The element type of dst must match the type of the slices returned by list.
+// Results collected before an error occurred are still appended to dst.
+func (c *Client) PaginateAll(ctx context.Context, opt *PaginateOptions, list ListFunc, dst interface{}) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Ptr || d.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("github: PaginateAll destination is %T, want a pointer to a slice", dst)
+	}
+	s := d.Elem()
+	elem := s.Type().Elem()
+
+	return c.Paginate(ctx, opt, list, func(v interface{}) error {
+		rv := reflect.ValueOf(v)
+		if !rv.Type().AssignableTo(elem) {
+			return fmt.Errorf("github: PaginateAll cannot append %T to %v", v, s.Type())
+		}
+		s.Set(reflect.Append(s, rv))
+		return nil
+	})
+}
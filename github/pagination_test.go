@@ -0,0 +1,186 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// setupPagedEvents registers a handler serving three pages of events, two
+// events per page, with Link headers pointing to the next page.
+func setupPagedEvents(t *testing.T) {
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "", "0", "1":
+			w.Header().Set("Link", `<`+server.URL+`/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
+		case "2":
+			w.Header().Set("Link", `<`+server.URL+`/events?page=3>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"3"},{"id":"4"}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":"5"},{"id":"6"}]`)
+		default:
+			t.Errorf("Unexpected page %q requested", page)
+		}
+	})
+}
+
+func listEvents(ctx context.Context, opt *ListOptions) (interface{}, *Response, error) {
+	return client.Activity.ListEvents(ctx, opt)
+}
+
+func TestPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	var ids []string
+	err := client.Paginate(context.Background(), nil, listEvents, func(v interface{}) error {
+		ids = append(ids, *v.(Event).ID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Paginate returned error: %v", err)
+	}
+
+	want := []string{"1", "2", "3", "4", "5", "6"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("Paginate visited %v, want %v", ids, want)
+	}
+}
+
+func TestPaginate_startPageAndMaxPages(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	var ids []string
+	opt := &PaginateOptions{ListOptions: ListOptions{Page: 2}, MaxPages: 1}
+	err := client.Paginate(context.Background(), opt, listEvents, func(v interface{}) error {
+		ids = append(ids, *v.(Event).ID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Paginate returned error: %v", err)
+	}
+
+	want := []string{"3", "4"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("Paginate visited %v, want %v", ids, want)
+	}
+}
+
+func TestPaginate_stop(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	var ids []string
+	err := client.Paginate(context.Background(), nil, listEvents, func(v interface{}) error {
+		ids = append(ids, *v.(Event).ID)
+		if len(ids) == 3 {
+			return ErrStopPagination
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Paginate returned error: %v", err)
+	}
+
+	want := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("Paginate visited %v, want %v", ids, want)
+	}
+}
+
+func TestPaginate_stopWrapped(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	var ids []string
+	err := client.Paginate(context.Background(), nil, listEvents, func(v interface{}) error {
+		ids = append(ids, *v.(Event).ID)
+		return fmt.Errorf("found event %v: %w", *v.(Event).ID, ErrStopPagination)
+	})
+	if err != nil {
+		t.Errorf("Paginate returned error: %v", err)
+	}
+
+	want := []string{"1"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("Paginate visited %v, want %v", ids, want)
+	}
+}
+
+func TestPaginate_callbackError(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	want := errors.New("boom")
+	err := client.Paginate(context.Background(), nil, listEvents, func(v interface{}) error {
+		return want
+	})
+	if err != want {
+		t.Errorf("Paginate returned error %v, want %v", err, want)
+	}
+}
+
+func TestPaginate_canceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	list := func(ctx context.Context, opt *ListOptions) (interface{}, *Response, error) {
+		t.Errorf("list function called with canceled context")
+		return nil, nil, nil
+	}
+	err := client.Paginate(ctx, nil, list, func(v interface{}) error { return nil })
+	if err != context.Canceled {
+		t.Errorf("Paginate returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestPaginate_notSlice(t *testing.T) {
+	list := func(ctx context.Context, opt *ListOptions) (interface{}, *Response, error) {
+		return &Event{}, nil, nil
+	}
+	err := client.Paginate(context.Background(), nil, list, func(v interface{}) error { return nil })
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestPaginateAll(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPagedEvents(t)
+
+	var events []Event
+	err := client.PaginateAll(context.Background(), &PaginateOptions{MaxPages: 2}, listEvents, &events)
+	if err != nil {
+		t.Errorf("PaginateAll returned error: %v", err)
+	}
+
+	want := []Event{{ID: String("1")}, {ID: String("2")}, {ID: String("3")}, {ID: String("4")}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("PaginateAll returned %+v, want %+v", events, want)
+	}
+}
+
+func TestPaginateAll_badDestination(t *testing.T) {
+	var events []Event
+	err := client.PaginateAll(context.Background(), nil, listEvents, events)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
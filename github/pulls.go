@@ -6,8 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -25,6 +25,8 @@ type PullRequest struct {
 	State        *string    `json:"state,omitempty"`
 	Title        *string    `json:"title,omitempty"`
 	Body         *string    `json:"body,omitempty"`
+	BodyHTML     *string    `json:"body_html,omitempty"` // see WithBodyFormat
+	BodyText     *string    `json:"body_text,omitempty"`
 	CreatedAt    *time.Time `json:"created_at,omitempty"`
 	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
 	ClosedAt     *time.Time `json:"closed_at,omitempty"`
@@ -51,28 +53,26 @@ func (p PullRequest) String() string {
 type PullRequestListOptions struct {
 	// State filters pull requests based on their state.  Possible values are:
 	// open, closed.  Default is "open".
-	State string
+	State string `url:"state,omitempty"`
 
 	// Head filters pull requests by head user and branch name in the format of:
 	// "user:ref-name".
-	Head string
+	Head string `url:"head,omitempty"`
 
 	// Base filters pull requests by base branch name.
-	Base string
+	Base string `url:"base,omitempty"`
+
+	ListOptions
 }
 
 // List the pull requests for the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/pulls/#list-pull-requests
-func (s *PullRequestsService) List(owner string, repo string, opt *PullRequestListOptions) ([]PullRequest, *Response, error) {
+func (s *PullRequestsService) List(ctx context.Context, owner string, repo string, opt *PullRequestListOptions) ([]PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"state": {opt.State},
-			"head":  {opt.Head},
-			"base":  {opt.Base},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -81,48 +81,57 @@ func (s *PullRequestsService) List(owner string, repo string, opt *PullRequestLi
 	}
 
 	pulls := new([]PullRequest)
-	resp, err := s.client.Do(req, pulls)
+	resp, err := s.client.Do(ctx, req, pulls)
 	return *pulls, resp, err
 }
 
 // Get a single pull request.
 //
 // GitHub API docs: https://developer.github.com/v3/pulls/#get-a-single-pull-request
-func (s *PullRequestsService) Get(owner string, repo string, number int) (*PullRequest, *Response, error) {
+func (s *PullRequestsService) Get(ctx context.Context, owner string, repo string, number int) (*PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	pull := new(PullRequest)
-	resp, err := s.client.Do(req, pull)
+	resp, err := s.client.Do(ctx, req, pull)
 	return pull, resp, err
 }
 
+// GetRaw gets a single pull request in the raw representation t, either Diff
+// or Patch.
+//
+// GitHub API docs: https://developer.github.com/v3/pulls/#get-a-single-pull-request
+func (s *PullRequestsService) GetRaw(ctx context.Context, owner string, repo string, number int, t RawType) ([]byte, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	return s.client.getRaw(ctx, u, t)
+}
+
 // Create a new pull request on the specified repository.
 //
 // GitHub API docs: https://developer.github.com/v3/pulls/#create-a-pull-request
-func (s *PullRequestsService) Create(owner string, repo string, pull *PullRequest) (*PullRequest, *Response, error) {
+func (s *PullRequestsService) Create(ctx context.Context, owner string, repo string, pull *PullRequest) (*PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls", owner, repo)
 	req, err := s.client.NewRequest("POST", u, pull)
 	if err != nil {
 		return nil, nil, err
 	}
 	p := new(PullRequest)
-	resp, err := s.client.Do(req, p)
+	resp, err := s.client.Do(ctx, req, p)
 	return p, resp, err
 }
 
 // Edit a pull request.
 //
 // GitHub API docs: https://developer.github.com/v3/pulls/#update-a-pull-request
-func (s *PullRequestsService) Edit(owner string, repo string, number int, pull *PullRequest) (*PullRequest, *Response, error) {
+func (s *PullRequestsService) Edit(ctx context.Context, owner string, repo string, number int, pull *PullRequest) (*PullRequest, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
 	req, err := s.client.NewRequest("PATCH", u, pull)
 	if err != nil {
 		return nil, nil, err
 	}
 	p := new(PullRequest)
-	resp, err := s.client.Do(req, p)
+	resp, err := s.client.Do(ctx, req, p)
 	return p, resp, err
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,14 +25,16 @@ func TestPullRequestsService_ListComments_allPulls(t *testing.T) {
 			"sort":      "updated",
 			"direction": "desc",
 			"since":     "2002-02-10T15:30:00Z",
+			"page":      "2",
 		})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
 	opt := &PullRequestListCommentsOptions{"updated", "desc",
 		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		ListOptions{Page: 2},
 	}
-	pulls, _, err := client.PullRequests.ListComments("o", "r", 0, opt)
+	pulls, _, err := client.PullRequests.ListComments(context.Background(), "o", "r", 0, opt)
 
 	if err != nil {
 		t.Errorf("PullRequests.ListComments returned error: %v", err)
@@ -52,7 +55,7 @@ func TestPullRequestsService_ListComments_specificPull(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	pulls, _, err := client.PullRequests.ListComments("o", "r", 1, nil)
+	pulls, _, err := client.PullRequests.ListComments(context.Background(), "o", "r", 1, nil)
 
 	if err != nil {
 		t.Errorf("PullRequests.ListComments returned error: %v", err)
@@ -65,7 +68,7 @@ func TestPullRequestsService_ListComments_specificPull(t *testing.T) {
 }
 
 func TestPullRequestsService_ListComments_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.ListComments("%", "r", 1, nil)
+	_, _, err := client.PullRequests.ListComments(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -78,7 +81,7 @@ func TestPullRequestsService_GetComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.PullRequests.GetComment("o", "r", 1)
+	comment, _, err := client.PullRequests.GetComment(context.Background(), "o", "r", 1)
 
 	if err != nil {
 		t.Errorf("PullRequests.GetComment returned error: %v", err)
@@ -91,7 +94,7 @@ func TestPullRequestsService_GetComment(t *testing.T) {
 }
 
 func TestPullRequestsService_GetComment_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.GetComment("%", "r", 1)
+	_, _, err := client.PullRequests.GetComment(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
 
@@ -113,7 +116,7 @@ func TestPullRequestsService_CreateComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.PullRequests.CreateComment("o", "r", 1, input)
+	comment, _, err := client.PullRequests.CreateComment(context.Background(), "o", "r", 1, input)
 
 	if err != nil {
 		t.Errorf("PullRequests.CreateComment returned error: %v", err)
@@ -126,7 +129,7 @@ func TestPullRequestsService_CreateComment(t *testing.T) {
 }
 
 func TestPullRequestsService_CreateComment_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.CreateComment("%", "r", 1, nil)
+	_, _, err := client.PullRequests.CreateComment(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -148,7 +151,7 @@ func TestPullRequestsService_EditComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.PullRequests.EditComment("o", "r", 1, input)
+	comment, _, err := client.PullRequests.EditComment(context.Background(), "o", "r", 1, input)
 
 	if err != nil {
 		t.Errorf("PullRequests.EditComment returned error: %v", err)
@@ -161,7 +164,7 @@ func TestPullRequestsService_EditComment(t *testing.T) {
 }
 
 func TestPullRequestsService_EditComment_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.EditComment("%", "r", 1, nil)
+	_, _, err := client.PullRequests.EditComment(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
 
@@ -173,13 +176,13 @@ func TestPullRequestsService_DeleteComment(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.PullRequests.DeleteComment("o", "r", 1)
+	_, err := client.PullRequests.DeleteComment(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("PullRequests.DeleteComment returned error: %v", err)
 	}
 }
 
 func TestPullRequestsService_DeleteComment_invalidOwner(t *testing.T) {
-	_, err := client.PullRequests.DeleteComment("%", "r", 1)
+	_, err := client.PullRequests.DeleteComment(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,12 +24,13 @@ func TestPullRequestsService_List(t *testing.T) {
 			"state": "closed",
 			"head":  "h",
 			"base":  "b",
+			"page":  "2",
 		})
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
 
-	opt := &PullRequestListOptions{"closed", "h", "b"}
-	pulls, _, err := client.PullRequests.List("o", "r", opt)
+	opt := &PullRequestListOptions{"closed", "h", "b", ListOptions{Page: 2}}
+	pulls, _, err := client.PullRequests.List(context.Background(), "o", "r", opt)
 
 	if err != nil {
 		t.Errorf("PullRequests.List returned error: %v", err)
@@ -41,7 +43,7 @@ func TestPullRequestsService_List(t *testing.T) {
 }
 
 func TestPullRequestsService_List_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.List("%", "r", nil)
+	_, _, err := client.PullRequests.List(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -54,7 +56,7 @@ func TestPullRequestsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"number":1}`)
 	})
 
-	pull, _, err := client.PullRequests.Get("o", "r", 1)
+	pull, _, err := client.PullRequests.Get(context.Background(), "o", "r", 1)
 
 	if err != nil {
 		t.Errorf("PullRequests.Get returned error: %v", err)
@@ -66,8 +68,35 @@ func TestPullRequestsService_Get(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_GetRaw(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.diff")
+		fmt.Fprint(w, "diff --git a/f b/f")
+	})
+
+	diff, _, err := client.PullRequests.GetRaw(context.Background(), "o", "r", 1, Diff)
+	if err != nil {
+		t.Errorf("PullRequests.GetRaw returned error: %v", err)
+	}
+
+	if want := "diff --git a/f b/f"; string(diff) != want {
+		t.Errorf("PullRequests.GetRaw returned %q, want %q", diff, want)
+	}
+}
+
+func TestPullRequestsService_GetRaw_invalidType(t *testing.T) {
+	_, _, err := client.PullRequests.GetRaw(context.Background(), "o", "r", 1, RawType("html"))
+	if err == nil {
+		t.Error("Expected error to be returned")
+	}
+}
+
 func TestPullRequestsService_Get_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.Get("%", "r", 1)
+	_, _, err := client.PullRequests.Get(context.Background(), "%", "r", 1)
 	testURLParseError(t, err)
 }
 
@@ -89,7 +118,7 @@ func TestPullRequestsService_Create(t *testing.T) {
 		fmt.Fprint(w, `{"number":1}`)
 	})
 
-	pull, _, err := client.PullRequests.Create("o", "r", input)
+	pull, _, err := client.PullRequests.Create(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("PullRequests.Create returned error: %v", err)
 	}
@@ -101,7 +130,7 @@ func TestPullRequestsService_Create(t *testing.T) {
 }
 
 func TestPullRequestsService_Create_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.Create("%", "r", nil)
+	_, _, err := client.PullRequests.Create(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -123,7 +152,7 @@ func TestPullRequestsService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"number":1}`)
 	})
 
-	pull, _, err := client.PullRequests.Edit("o", "r", 1, input)
+	pull, _, err := client.PullRequests.Edit(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("PullRequests.Edit returned error: %v", err)
 	}
@@ -135,6 +164,6 @@ func TestPullRequestsService_Edit(t *testing.T) {
 }
 
 func TestPullRequestsService_Edit_invalidOwner(t *testing.T) {
-	_, _, err := client.PullRequests.Edit("%", "r", 1, nil)
+	_, _, err := client.PullRequests.Edit(context.Background(), "%", "r", 1, nil)
 	testURLParseError(t, err)
 }
@@ -6,9 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
 )
 
 // RepositoriesService handles communication with the repository related
@@ -46,39 +45,33 @@ func (r Repository) String() string {
 type RepositoryListOptions struct {
 	// Type of repositories to list.  Possible values are: all, owner, public,
 	// private, member.  Default is "all".
-	Type string
+	Type string `url:"type,omitempty"`
 
 	// How to sort the repository list.  Possible values are: created, updated,
 	// pushed, full_name.  Default is "full_name".
-	Sort string
+	Sort string `url:"sort,omitempty"`
 
 	// Direction in which to sort repositories.  Possible values are: asc, desc.
 	// Default is "asc" when sort is "full_name", otherwise default is "desc".
-	Direction string
+	Direction string `url:"direction,omitempty"`
 
-	// For paginated result sets, page of results to retrieve.
-	Page int
+	ListOptions
 }
 
 // List the repositories for a user.  Passing the empty string will list
 // repositories for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#list-user-repositories
-func (s *RepositoriesService) List(user string, opt *RepositoryListOptions) ([]Repository, *Response, error) {
+func (s *RepositoriesService) List(ctx context.Context, user string, opt *RepositoryListOptions) ([]Repository, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/repos", user)
 	} else {
 		u = "user/repos"
 	}
-	if opt != nil {
-		params := url.Values{
-			"type":      []string{opt.Type},
-			"sort":      []string{opt.Sort},
-			"direction": []string{opt.Direction},
-			"page":      []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -87,7 +80,7 @@ func (s *RepositoriesService) List(user string, opt *RepositoryListOptions) ([]R
 	}
 
 	repos := new([]Repository)
-	resp, err := s.client.Do(req, repos)
+	resp, err := s.client.Do(ctx, req, repos)
 	return *repos, resp, err
 }
 
@@ -96,23 +89,19 @@ func (s *RepositoriesService) List(user string, opt *RepositoryListOptions) ([]R
 type RepositoryListByOrgOptions struct {
 	// Type of repositories to list.  Possible values are: all, public, private,
 	// forks, sources, member.  Default is "all".
-	Type string
+	Type string `url:"type,omitempty"`
 
-	// For paginated result sets, page of results to retrieve.
-	Page int
+	ListOptions
 }
 
 // ListByOrg lists the repositories for an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#list-organization-repositories
-func (s *RepositoriesService) ListByOrg(org string, opt *RepositoryListByOrgOptions) ([]Repository, *Response, error) {
+func (s *RepositoriesService) ListByOrg(ctx context.Context, org string, opt *RepositoryListByOrgOptions) ([]Repository, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/repos", org)
-	if opt != nil {
-		params := url.Values{
-			"type": []string{opt.Type},
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -121,7 +110,7 @@ func (s *RepositoriesService) ListByOrg(org string, opt *RepositoryListByOrgOpti
 	}
 
 	repos := new([]Repository)
-	resp, err := s.client.Do(req, repos)
+	resp, err := s.client.Do(ctx, req, repos)
 	return *repos, resp, err
 }
 
@@ -129,19 +118,19 @@ func (s *RepositoriesService) ListByOrg(org string, opt *RepositoryListByOrgOpti
 // RepositoriesService.ListAll method.
 type RepositoryListAllOptions struct {
 	// ID of the last repository seen
-	Since int
+	Since int `url:"since,omitempty"`
+
+	ListOptions
 }
 
 // ListAll lists all GitHub repositories in the order that they were created.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#list-all-repositories
-func (s *RepositoriesService) ListAll(opt *RepositoryListAllOptions) ([]Repository, *Response, error) {
+func (s *RepositoriesService) ListAll(ctx context.Context, opt *RepositoryListAllOptions) ([]Repository, *Response, error) {
 	u := "repositories"
-	if opt != nil {
-		params := url.Values{
-			"since": []string{strconv.Itoa(opt.Since)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -150,7 +139,7 @@ func (s *RepositoriesService) ListAll(opt *RepositoryListAllOptions) ([]Reposito
 	}
 
 	repos := new([]Repository)
-	resp, err := s.client.Do(req, repos)
+	resp, err := s.client.Do(ctx, req, repos)
 	return *repos, resp, err
 }
 
@@ -159,7 +148,7 @@ func (s *RepositoriesService) ListAll(opt *RepositoryListAllOptions) ([]Reposito
 // specified, it will be created for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#create
-func (s *RepositoriesService) Create(org string, repo *Repository) (*Repository, *Response, error) {
+func (s *RepositoriesService) Create(ctx context.Context, org string, repo *Repository) (*Repository, *Response, error) {
 	var u string
 	if org != "" {
 		u = fmt.Sprintf("orgs/%v/repos", org)
@@ -173,35 +162,35 @@ func (s *RepositoriesService) Create(org string, repo *Repository) (*Repository,
 	}
 
 	r := new(Repository)
-	resp, err := s.client.Do(req, r)
+	resp, err := s.client.Do(ctx, req, r)
 	return r, resp, err
 }
 
 // Get fetches a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#get
-func (s *RepositoriesService) Get(owner, repo string) (*Repository, *Response, error) {
+func (s *RepositoriesService) Get(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v", owner, repo)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	repository := new(Repository)
-	resp, err := s.client.Do(req, repository)
+	resp, err := s.client.Do(ctx, req, repository)
 	return repository, resp, err
 }
 
 // Edit updates a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/#edit
-func (s *RepositoriesService) Edit(owner, repo string, repository *Repository) (*Repository, *Response, error) {
+func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repository *Repository) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v", owner, repo)
 	req, err := s.client.NewRequest("PATCH", u, repository)
 	if err != nil {
 		return nil, nil, err
 	}
 	r := new(Repository)
-	resp, err := s.client.Do(req, r)
+	resp, err := s.client.Do(ctx, req, r)
 	return r, resp, err
 }
 
@@ -215,7 +204,7 @@ func (s *RepositoriesService) Edit(owner, repo string, repository *Repository) (
 //     }
 //
 // GitHub API Docs: http://developer.github.com/v3/repos/#list-languages
-func (s *RepositoriesService) ListLanguages(owner string, repository string) (map[string]int, *Response, error) {
+func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, repository string) (map[string]int, *Response, error) {
 	u := fmt.Sprintf("/repos/%v/%v/languages", owner, repository)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -223,6 +212,6 @@ func (s *RepositoriesService) ListLanguages(owner string, repository string) (ma
 	}
 
 	languages := make(map[string]int)
-	resp, err := s.client.Do(req, &languages)
+	resp, err := s.client.Do(ctx, req, &languages)
 	return languages, resp, err
 }
@@ -0,0 +1,194 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// mimeProtectedBranchesPreview is the media type of the preview of protected
+// branches, required by the protection endpoints.  It also adds Protected to
+// branches.
+const mimeProtectedBranchesPreview = "application/vnd.github.loki-preview+json"
+
+// Branch represents a repository branch.
+type Branch struct {
+	Name      *string           `json:"name,omitempty"`
+	Commit    *RepositoryCommit `json:"commit,omitempty"`
+	Protected *bool             `json:"protected,omitempty"`
+}
+
+func (b Branch) String() string {
+	return Stringify(b)
+}
+
+// Protection represents the protection settings of a branch.  Settings that
+// are not enforced are nil.
+type Protection struct {
+	RequiredStatusChecks       *RequiredStatusChecks       `json:"required_status_checks,omitempty"`
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews,omitempty"`
+	EnforceAdmins              *AdminEnforcement           `json:"enforce_admins,omitempty"`
+	Restrictions               *BranchRestrictions         `json:"restrictions,omitempty"`
+}
+
+func (p Protection) String() string {
+	return Stringify(p)
+}
+
+// RequiredStatusChecks represents the status checks that must pass before a
+// branch can be merged into a protected branch.
+type RequiredStatusChecks struct {
+	// Strict requires the branch to be up to date with the protected branch
+	// before merging.
+	Strict bool `json:"strict"`
+
+	// Contexts are the status contexts that must pass.  UpdateBranchProtection
+	// sends nil Contexts as an empty list.
+	Contexts []string `json:"contexts"`
+}
+
+// RequiredPullRequestReviews represents the reviews required before a pull
+// request can be merged into a protected branch.
+type RequiredPullRequestReviews struct {
+	// DismissStaleReviews dismisses approving reviews when new commits are
+	// pushed.
+	DismissStaleReviews bool `json:"dismiss_stale_reviews"`
+
+	// RequireCodeOwnerReviews requires an approving review from a code
+	// owner of the changed files.
+	RequireCodeOwnerReviews bool `json:"require_code_owner_reviews"`
+
+	// RequiredApprovingReviewCount is the number of approving reviews
+	// required, from 1 to 6.  GitHub requires one if it is nil.
+	RequiredApprovingReviewCount *int `json:"required_approving_review_count,omitempty"`
+}
+
+// AdminEnforcement represents whether the protection of a branch applies to
+// repository administrators.
+type AdminEnforcement struct {
+	URL     *string `json:"url,omitempty"`
+	Enabled bool    `json:"enabled"`
+}
+
+// BranchRestrictions represents the users and teams allowed to push to a
+// protected branch.
+type BranchRestrictions struct {
+	Users []User `json:"users"`
+	Teams []Team `json:"teams"`
+}
+
+// ProtectionRequest represents the protection settings sent to
+// RepositoriesService.UpdateBranchProtection.  Every setting replaces the
+// current one, and a nil setting removes it.
+type ProtectionRequest struct {
+	RequiredStatusChecks       *RequiredStatusChecks       `json:"required_status_checks"`
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews"`
+	EnforceAdmins              bool                        `json:"enforce_admins"`
+	Restrictions               *BranchRestrictionsRequest  `json:"restrictions"`
+}
+
+// BranchRestrictionsRequest represents the users and teams allowed to push
+// to a protected branch, by login and team slug.  Only organization
+// repositories can restrict pushes.
+type BranchRestrictionsRequest struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
+// ListBranches lists the branches of a repository.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/branches/#list-branches
+func (s *RepositoriesService) ListBranches(ctx context.Context, owner, repo string, opt *ListOptions) ([]Branch, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeProtectedBranchesPreview)
+
+	branches := new([]Branch)
+	resp, err := s.client.Do(ctx, req, branches)
+	return *branches, resp, err
+}
+
+// GetBranch gets a branch, with its head commit.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/branches/#get-branch
+func (s *RepositoriesService) GetBranch(ctx context.Context, owner, repo, branch string) (*Branch, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v", owner, repo, branch)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeProtectedBranchesPreview)
+
+	b := new(Branch)
+	resp, err := s.client.Do(ctx, req, b)
+	return b, resp, err
+}
+
+// GetBranchProtection gets the protection settings of a branch.  GitHub
+// answers 404 Not Found if the branch is not protected.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/branches/#get-branch-protection
+func (s *RepositoriesService) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*Protection, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeProtectedBranchesPreview)
+
+	p := new(Protection)
+	resp, err := s.client.Do(ctx, req, p)
+	return p, resp, err
+}
+
+// UpdateBranchProtection replaces the protection settings of a branch,
+// protecting it if it was not.  GitHub rejects null status contexts, so nil
+// preq.RequiredStatusChecks.Contexts are sent as an empty list.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/branches/#update-branch-protection
+func (s *RepositoriesService) UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *ProtectionRequest) (*Protection, *Response, error) {
+	if preq != nil && preq.RequiredStatusChecks != nil && preq.RequiredStatusChecks.Contexts == nil {
+		checks := *preq.RequiredStatusChecks
+		checks.Contexts = []string{}
+		r := *preq
+		r.RequiredStatusChecks = &checks
+		preq = &r
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
+	req, err := s.client.NewRequest("PUT", u, preq)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeProtectedBranchesPreview)
+
+	p := new(Protection)
+	resp, err := s.client.Do(ctx, req, p)
+	return p, resp, err
+}
+
+// RemoveBranchProtection removes the protection of a branch.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/branches/#remove-branch-protection
+func (s *RepositoriesService) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mimeProtectedBranchesPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,196 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListBranches(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mimeProtectedBranchesPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"name":"master","commit":{"sha":"s"},"protected":true}]`)
+	})
+
+	branches, _, err := client.Repositories.ListBranches(context.Background(), "o", "r", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListBranches returned error: %v", err)
+	}
+
+	want := []Branch{{Name: String("master"), Commit: &RepositoryCommit{SHA: String("s")}, Protected: Bool(true)}}
+	if !reflect.DeepEqual(branches, want) {
+		t.Errorf("Repositories.ListBranches returned %+v, want %+v", branches, want)
+	}
+}
+
+func TestRepositoriesService_ListBranches_invalidOwner(t *testing.T) {
+	_, _, err := client.Repositories.ListBranches(context.Background(), "%", "r", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_GetBranch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"b","commit":{"sha":"s","commit":{"message":"m"}}}`)
+	})
+
+	branch, _, err := client.Repositories.GetBranch(context.Background(), "o", "r", "b")
+	if err != nil {
+		t.Errorf("Repositories.GetBranch returned error: %v", err)
+	}
+
+	want := &Branch{
+		Name:   String("b"),
+		Commit: &RepositoryCommit{SHA: String("s"), Commit: &Commit{Message: String("m")}},
+	}
+	if !reflect.DeepEqual(branch, want) {
+		t.Errorf("Repositories.GetBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestRepositoriesService_GetBranchProtection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mimeProtectedBranchesPreview)
+		fmt.Fprint(w, `{
+			"required_status_checks": {"strict": true, "contexts": ["ci"]},
+			"required_pull_request_reviews": {"dismiss_stale_reviews": true, "required_approving_review_count": 2},
+			"enforce_admins": {"url": "u", "enabled": true},
+			"restrictions": {"users": [{"login": "u"}], "teams": [{"slug": "t"}]}
+		}`)
+	})
+
+	protection, _, err := client.Repositories.GetBranchProtection(context.Background(), "o", "r", "b")
+	if err != nil {
+		t.Errorf("Repositories.GetBranchProtection returned error: %v", err)
+	}
+
+	want := &Protection{
+		RequiredStatusChecks: &RequiredStatusChecks{Strict: true, Contexts: []string{"ci"}},
+		RequiredPullRequestReviews: &RequiredPullRequestReviews{
+			DismissStaleReviews:          true,
+			RequiredApprovingReviewCount: Int(2),
+		},
+		EnforceAdmins: &AdminEnforcement{URL: String("u"), Enabled: true},
+		Restrictions: &BranchRestrictions{
+			Users: []User{{Login: String("u")}},
+			Teams: []Team{{Slug: String("t")}},
+		},
+	}
+	if !reflect.DeepEqual(protection, want) {
+		t.Errorf("Repositories.GetBranchProtection returned %+v, want %+v", protection, want)
+	}
+}
+
+func TestRepositoriesService_UpdateBranchProtection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ProtectionRequest{
+		RequiredStatusChecks: &RequiredStatusChecks{Contexts: []string{"ci"}},
+		EnforceAdmins:        true,
+		Restrictions:         &BranchRestrictionsRequest{Users: []string{"u"}, Teams: []string{}},
+	}
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mimeProtectedBranchesPreview)
+		// Settings left nil must be sent as null to remove them.
+		if v, ok := body["required_pull_request_reviews"]; !ok || v != nil {
+			t.Errorf("Request body required_pull_request_reviews = %v, want null", v)
+		}
+		if v := body["enforce_admins"]; v != true {
+			t.Errorf("Request body enforce_admins = %v, want true", v)
+		}
+
+		fmt.Fprint(w, `{"required_status_checks":{"strict":false,"contexts":["ci"]}}`)
+	})
+
+	protection, _, err := client.Repositories.UpdateBranchProtection(context.Background(), "o", "r", "b", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateBranchProtection returned error: %v", err)
+	}
+
+	want := &Protection{RequiredStatusChecks: &RequiredStatusChecks{Contexts: []string{"ci"}}}
+	if !reflect.DeepEqual(protection, want) {
+		t.Errorf("Repositories.UpdateBranchProtection returned %+v, want %+v", protection, want)
+	}
+}
+
+func TestRepositoriesService_UpdateBranchProtection_nilContexts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ProtectionRequest{
+		RequiredStatusChecks:       &RequiredStatusChecks{Strict: true},
+		RequiredPullRequestReviews: &RequiredPullRequestReviews{DismissStaleReviews: true},
+	}
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		want := map[string]interface{}{
+			"required_status_checks": map[string]interface{}{
+				"strict":   true,
+				"contexts": []interface{}{},
+			},
+			"required_pull_request_reviews": map[string]interface{}{
+				"dismiss_stale_reviews":      true,
+				"require_code_owner_reviews": false,
+			},
+			"enforce_admins": false,
+			"restrictions":   nil,
+		}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Repositories.UpdateBranchProtection(context.Background(), "o", "r", "b", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateBranchProtection returned error: %v", err)
+	}
+	if input.RequiredStatusChecks.Contexts != nil {
+		t.Errorf("Repositories.UpdateBranchProtection modified input Contexts to %#v", input.RequiredStatusChecks.Contexts)
+	}
+}
+
+func TestRepositoriesService_RemoveBranchProtection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mimeProtectedBranchesPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Repositories.RemoveBranchProtection(context.Background(), "o", "r", "b")
+	if err != nil {
+		t.Errorf("Repositories.RemoveBranchProtection returned error: %v", err)
+	}
+}
@@ -6,21 +6,27 @@
 package github
 
 import (
+	"context"
 	"fmt"
 )
 
 // ListCollaborators lists the Github users that have access to the repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/collaborators/#list
-func (s *RepositoriesService) ListCollaborators(owner, repo string) ([]User, *Response, error) {
+func (s *RepositoriesService) ListCollaborators(ctx context.Context, owner, repo string, opt *ListOptions) ([]User, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/collaborators", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	users := new([]User)
-	resp, err := s.client.Do(req, users)
+	resp, err := s.client.Do(ctx, req, users)
 	return *users, resp, err
 }
 
@@ -30,13 +36,13 @@ func (s *RepositoriesService) ListCollaborators(owner, repo string) ([]User, *Re
 // is not a GitHub user.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/collaborators/#get
-func (s *RepositoriesService) IsCollaborator(owner, repo, user string) (bool, *Response, error) {
+func (s *RepositoriesService) IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
 	isCollab, err := parseBoolResponse(err)
 	return isCollab, resp, err
 }
@@ -44,13 +50,13 @@ func (s *RepositoriesService) IsCollaborator(owner, repo, user string) (bool, *R
 // AddCollaborator adds the specified Github user as collaborator to the given repo.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/collaborators/#add-collaborator
-func (s *RepositoriesService) AddCollaborator(owner, repo, user string) (*Response, error) {
+func (s *RepositoriesService) AddCollaborator(ctx context.Context, owner, repo, user string) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
 	return resp, err
 }
 
@@ -58,12 +64,12 @@ func (s *RepositoriesService) AddCollaborator(owner, repo, user string) (*Respon
 // Note: Does not return error if a valid user that is not a collaborator is removed.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/collaborators/#remove-collaborator
-func (s *RepositoriesService) RemoveCollaborator(owner, repo, user string) (*Response, error) {
+func (s *RepositoriesService) RemoveCollaborator(ctx context.Context, owner, repo, user string) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
 	return resp, err
 }
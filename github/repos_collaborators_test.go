@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -18,10 +19,12 @@ func TestRepositoriesService_ListCollaborators(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/collaborators", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprintf(w, `[{"id":1}, {"id":2}]`)
 	})
 
-	users, _, err := client.Repositories.ListCollaborators("o", "r")
+	opt := &ListOptions{Page: 2}
+	users, _, err := client.Repositories.ListCollaborators(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListCollaborators returned error: %v", err)
 	}
@@ -41,7 +44,7 @@ func TestRepositoriesService_IsCollaborator_True(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	isCollab, _, err := client.Repositories.IsCollaborator("o", "r", "u")
+	isCollab, _, err := client.Repositories.IsCollaborator(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Repositories.IsCollaborator returned error: %v", err)
 	}
@@ -60,7 +63,7 @@ func TestRepositoriesService_IsCollaborator_False(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	isCollab, _, err := client.Repositories.IsCollaborator("o", "r", "u")
+	isCollab, _, err := client.Repositories.IsCollaborator(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Repositories.IsCollaborator returned error: %v", err)
 	}
@@ -79,7 +82,7 @@ func TestRepositoriesService_AddCollaborator(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Repositories.AddCollaborator("o", "r", "u")
+	_, err := client.Repositories.AddCollaborator(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Repositories.AddCollaborator returned error: %v", err)
 	}
@@ -94,7 +97,7 @@ func TestRepositoriesService_RemoveCollaborator(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Repositories.RemoveCollaborator("o", "r", "u")
+	_, err := client.Repositories.RemoveCollaborator(context.Background(), "o", "r", "u")
 	if err != nil {
 		t.Errorf("Repositories.RemoveCollaborator returned error: %v", err)
 	}
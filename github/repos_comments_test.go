@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestRepositoriesService_ListComments(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/comments", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
 	})
 
-	comments, _, err := client.Repositories.ListComments("o", "r")
+	opt := &ListOptions{Page: 2}
+	comments, _, err := client.Repositories.ListComments(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListComments returned error: %v", err)
 	}
@@ -39,10 +42,12 @@ func TestRepositoriesService_ListCommitComments(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/commits/s/comments", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
 	})
 
-	comments, _, err := client.Repositories.ListCommitComments("o", "r", "s")
+	opt := &ListOptions{Page: 2}
+	comments, _, err := client.Repositories.ListCommitComments(context.Background(), "o", "r", "s", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListCommitComments returned error: %v", err)
 	}
@@ -71,7 +76,7 @@ func TestRepositoriesService_CreateComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Repositories.CreateComment("o", "r", "s", input)
+	comment, _, err := client.Repositories.CreateComment(context.Background(), "o", "r", "s", input)
 	if err != nil {
 		t.Errorf("Repositories.CreateComment returned error: %v", err)
 	}
@@ -91,7 +96,7 @@ func TestRepositoriesService_GetComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Repositories.GetComment("o", "r", 1)
+	comment, _, err := client.Repositories.GetComment(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.GetComment returned error: %v", err)
 	}
@@ -120,7 +125,7 @@ func TestRepositoriesService_UpdateComment(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	comment, _, err := client.Repositories.UpdateComment("o", "r", 1, input)
+	comment, _, err := client.Repositories.UpdateComment(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Repositories.UpdateComment returned error: %v", err)
 	}
@@ -139,7 +144,7 @@ func TestRepositoriesService_DeleteComment(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Repositories.DeleteComment("o", "r", 1)
+	_, err := client.Repositories.DeleteComment(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.DeleteComment returned error: %v", err)
 	}
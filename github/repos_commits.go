@@ -0,0 +1,172 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RepositoryCommit represents a commit in a repository.  Unlike a Commit,
+// which is the git object, it holds the GitHub users matching the author and
+// committer, and for a single commit the changes it makes.
+type RepositoryCommit struct {
+	SHA       *string  `json:"sha,omitempty"`
+	Commit    *Commit  `json:"commit,omitempty"`
+	Author    *User    `json:"author,omitempty"`
+	Committer *User    `json:"committer,omitempty"`
+	Parents   []Commit `json:"parents,omitempty"`
+	URL       *string  `json:"url,omitempty"`
+	HTMLURL   *string  `json:"html_url,omitempty"`
+
+	// Stats and Files are only set by GetCommit.
+	Stats *CommitStats `json:"stats,omitempty"`
+	Files []CommitFile `json:"files,omitempty"`
+}
+
+func (r RepositoryCommit) String() string {
+	return Stringify(r)
+}
+
+// CommitStats represents the number of lines added and deleted by a commit.
+type CommitStats struct {
+	Additions *int `json:"additions,omitempty"`
+	Deletions *int `json:"deletions,omitempty"`
+	Total     *int `json:"total,omitempty"`
+}
+
+func (c CommitStats) String() string {
+	return Stringify(c)
+}
+
+// CommitFile represents a file changed by a commit or comparison.  Patch is
+// not set for binary files or very large diffs.
+type CommitFile struct {
+	SHA              *string `json:"sha,omitempty"`
+	Filename         *string `json:"filename,omitempty"`
+	PreviousFilename *string `json:"previous_filename,omitempty"`
+	Status           *string `json:"status,omitempty"`
+	Additions        *int    `json:"additions,omitempty"`
+	Deletions        *int    `json:"deletions,omitempty"`
+	Changes          *int    `json:"changes,omitempty"`
+	Patch            *string `json:"patch,omitempty"`
+	BlobURL          *string `json:"blob_url,omitempty"`
+	RawURL           *string `json:"raw_url,omitempty"`
+	ContentsURL      *string `json:"contents_url,omitempty"`
+}
+
+func (c CommitFile) String() string {
+	return Stringify(c)
+}
+
+// CommitsComparison is the result of comparing two commits.  Status is one of
+// "ahead", "behind", "diverged" or "identical", describing head relative to
+// base.
+type CommitsComparison struct {
+	BaseCommit      *RepositoryCommit `json:"base_commit,omitempty"`
+	MergeBaseCommit *RepositoryCommit `json:"merge_base_commit,omitempty"`
+
+	Status       *string `json:"status,omitempty"`
+	AheadBy      *int    `json:"ahead_by,omitempty"`
+	BehindBy     *int    `json:"behind_by,omitempty"`
+	TotalCommits *int    `json:"total_commits,omitempty"`
+
+	// Commits are those reachable from head but not base, oldest first.
+	Commits []RepositoryCommit `json:"commits,omitempty"`
+	Files   []CommitFile       `json:"files,omitempty"`
+
+	URL          *string `json:"url,omitempty"`
+	HTMLURL      *string `json:"html_url,omitempty"`
+	PermalinkURL *string `json:"permalink_url,omitempty"`
+	DiffURL      *string `json:"diff_url,omitempty"`
+	PatchURL     *string `json:"patch_url,omitempty"`
+}
+
+func (c CommitsComparison) String() string {
+	return Stringify(c)
+}
+
+// CommitsListOptions specifies the optional parameters to the
+// RepositoriesService.ListCommits method.
+type CommitsListOptions struct {
+	// SHA or branch to start listing commits from.  Defaults to the default
+	// branch of the repository.
+	SHA string `url:"sha,omitempty"`
+
+	// Path filters commits to those touching a file or directory.
+	Path string `url:"path,omitempty"`
+
+	// Author filters commits by the GitHub login or email of their author.
+	Author string `url:"author,omitempty"`
+
+	// Since and Until filter commits by date.
+	Since time.Time `url:"since,omitempty"`
+	Until time.Time `url:"until,omitempty"`
+
+	ListOptions
+}
+
+// ListCommits lists the commits of a repository, newest first.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/commits/#list-commits-on-a-repository
+func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo string, opt *CommitsListOptions) ([]RepositoryCommit, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commits := new([]RepositoryCommit)
+	resp, err := s.client.Do(ctx, req, commits)
+	return *commits, resp, err
+}
+
+// GetCommit gets a single commit, with its stats and the files it changes.
+// sha may also be the name of a branch or tag.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/commits/#get-a-single-commit
+func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha string) (*RepositoryCommit, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(RepositoryCommit)
+	resp, err := s.client.Do(ctx, req, commit)
+	return commit, resp, err
+}
+
+// GetCommitRaw gets a single commit in the raw representation t, either Diff
+// or Patch.  sha may also be the name of a branch or tag.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/commits/#get-a-single-commit
+func (s *RepositoriesService) GetCommitRaw(ctx context.Context, owner, repo, sha string, t RawType) ([]byte, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
+	return s.client.getRaw(ctx, u, t)
+}
+
+// CompareCommits compares two commits, branches or tags.  head may be in
+// another fork of the repository, given as "user:branch".
+//
+// GitHub API docs: http://developer.github.com/v3/repos/commits/#compare-two-commits
+func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo, base, head string) (*CommitsComparison, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, base, head)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comp := new(CommitsComparison)
+	resp, err := s.client.Do(ctx, req, comp)
+	return comp, resp, err
+}
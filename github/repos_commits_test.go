@@ -0,0 +1,156 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRepositoriesService_GetCommitRaw(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.patch")
+		fmt.Fprint(w, "From s Mon Sep 17 00:00:00 2001")
+	})
+
+	patch, _, err := client.Repositories.GetCommitRaw(context.Background(), "o", "r", "s", Patch)
+	if err != nil {
+		t.Errorf("Repositories.GetCommitRaw returned error: %v", err)
+	}
+
+	if want := "From s Mon Sep 17 00:00:00 2001"; string(patch) != want {
+		t.Errorf("Repositories.GetCommitRaw returned %q, want %q", patch, want)
+	}
+}
+
+func TestRepositoriesService_GetCommitRaw_invalidOwner(t *testing.T) {
+	_, _, err := client.Repositories.GetCommitRaw(context.Background(), "%", "r", "s", Diff)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_ListCommits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"sha":    "s",
+			"path":   "p",
+			"author": "a",
+			"since":  "2013-08-01T00:00:00Z",
+			"until":  "2013-09-03T00:00:00Z",
+		})
+		fmt.Fprint(w, `[{"sha":"s","commit":{"message":"m"},"author":{"login":"a"}}]`)
+	})
+
+	opt := &CommitsListOptions{
+		SHA:    "s",
+		Path:   "p",
+		Author: "a",
+		Since:  time.Date(2013, time.August, 1, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2013, time.September, 3, 0, 0, 0, 0, time.UTC),
+	}
+	commits, _, err := client.Repositories.ListCommits(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListCommits returned error: %v", err)
+	}
+
+	want := []RepositoryCommit{{
+		SHA:    String("s"),
+		Commit: &Commit{Message: String("m")},
+		Author: &User{Login: String("a")},
+	}}
+	if !reflect.DeepEqual(commits, want) {
+		t.Errorf("Repositories.ListCommits returned %+v, want %+v", commits, want)
+	}
+}
+
+func TestRepositoriesService_ListCommits_invalidOwner(t *testing.T) {
+	_, _, err := client.Repositories.ListCommits(context.Background(), "%", "r", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_GetCommit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sha": "s",
+			"committer": {"login": "c"},
+			"stats": {"additions": 104, "deletions": 4, "total": 108},
+			"files": [{"filename": "f", "status": "modified", "additions": 10, "deletions": 2, "changes": 12, "patch": "@@ -1 +1 @@"}]
+		}`)
+	})
+
+	commit, _, err := client.Repositories.GetCommit(context.Background(), "o", "r", "s")
+	if err != nil {
+		t.Errorf("Repositories.GetCommit returned error: %v", err)
+	}
+
+	want := &RepositoryCommit{
+		SHA:       String("s"),
+		Committer: &User{Login: String("c")},
+		Stats:     &CommitStats{Additions: Int(104), Deletions: Int(4), Total: Int(108)},
+		Files: []CommitFile{{
+			Filename:  String("f"),
+			Status:    String("modified"),
+			Additions: Int(10),
+			Deletions: Int(2),
+			Changes:   Int(12),
+			Patch:     String("@@ -1 +1 @@"),
+		}},
+	}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("Repositories.GetCommit returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestRepositoriesService_CompareCommits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"status": "ahead",
+			"ahead_by": 1,
+			"behind_by": 0,
+			"total_commits": 1,
+			"base_commit": {"sha": "b"},
+			"commits": [{"sha": "h"}],
+			"files": [{"filename": "f"}]
+		}`)
+	})
+
+	comp, _, err := client.Repositories.CompareCommits(context.Background(), "o", "r", "b", "h")
+	if err != nil {
+		t.Errorf("Repositories.CompareCommits returned error: %v", err)
+	}
+
+	want := &CommitsComparison{
+		Status:       String("ahead"),
+		AheadBy:      Int(1),
+		BehindBy:     Int(0),
+		TotalCommits: Int(1),
+		BaseCommit:   &RepositoryCommit{SHA: String("b")},
+		Commits:      []RepositoryCommit{{SHA: String("h")}},
+		Files:        []CommitFile{{Filename: String("f")}},
+	}
+	if !reflect.DeepEqual(comp, want) {
+		t.Errorf("Repositories.CompareCommits returned %+v, want %+v", comp, want)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// mimeRaw is the media type of the raw contents of a file.
+const mimeRaw = mimeV3 + "raw"
+
+// RepositoryContent represents a file, directory, symlink or submodule in a
+// repository.  Content is only set when getting a single file.
+type RepositoryContent struct {
+	Type        *string `json:"type,omitempty"`
+	Encoding    *string `json:"encoding,omitempty"`
+	Size        *int    `json:"size,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Path        *string `json:"path,omitempty"`
+	Content     *string `json:"content,omitempty"`
+	SHA         *string `json:"sha,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	GitURL      *string `json:"git_url,omitempty"`
+	HTMLURL     *string `json:"html_url,omitempty"`
+	DownloadURL *string `json:"download_url,omitempty"`
+}
+
+func (r RepositoryContent) String() string {
+	return Stringify(r)
+}
+
+// Decode returns the content of the file, decoding it if it is base64
+// encoded.  GitHub wraps base64 content over several lines, which Decode
+// accepts.
+func (r *RepositoryContent) Decode() ([]byte, error) {
+	if r.Content == nil {
+		return nil, errors.New("github: content is not set")
+	}
+	encoding := ""
+	if r.Encoding != nil {
+		encoding = *r.Encoding
+	}
+	switch encoding {
+	case "":
+		return []byte(*r.Content), nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(stripNewlines(*r.Content))
+	default:
+		return nil, fmt.Errorf("github: unsupported content encoding %q", encoding)
+	}
+}
+
+// stripNewlines returns s without its line breaks.
+func stripNewlines(s string) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\n' && s[i] != '\r' {
+			b = append(b, s[i])
+		}
+	}
+	return string(b)
+}
+
+// RepositoryContentGetOptions specifies the optional parameters to the
+// RepositoriesService.GetContents, GetReadme and DownloadContents methods.
+type RepositoryContentGetOptions struct {
+	// Ref is the name of the commit, branch or tag to read from.  Defaults
+	// to the default branch of the repository.
+	Ref string `url:"ref,omitempty"`
+}
+
+// RepositoryContentFileOptions specifies the parameters to the
+// RepositoriesService.CreateFile, UpdateFile and DeleteFile methods.
+type RepositoryContentFileOptions struct {
+	// Message is the commit message.  It is required.
+	Message *string `json:"message,omitempty"`
+
+	// Content is the new content of the file.  It is sent base64 encoded,
+	// and ignored by DeleteFile.
+	Content []byte `json:"content,omitempty"`
+
+	// SHA is the blob SHA of the file being replaced or deleted.  It is
+	// required by UpdateFile and DeleteFile, which fail with a 409 Conflict
+	// if the file has changed since.
+	SHA *string `json:"sha,omitempty"`
+
+	// Branch is the branch to commit to.  Defaults to the default branch of
+	// the repository.
+	Branch *string `json:"branch,omitempty"`
+
+	// Author and Committer of the commit.  Both default to the
+	// authenticated user.
+	Author    *CommitAuthor `json:"author,omitempty"`
+	Committer *CommitAuthor `json:"committer,omitempty"`
+}
+
+// RepositoryContentResponse holds the file and the commit returned when a
+// file is created, updated or deleted.  Content is nil for DeleteFile.
+type RepositoryContentResponse struct {
+	Content *RepositoryContent `json:"content,omitempty"`
+	Commit  *Commit            `json:"commit,omitempty"`
+}
+
+func (r RepositoryContentResponse) String() string {
+	return Stringify(r)
+}
+
+// GetContents gets the contents of a file or directory in a repository.  If
+// path is a file, fileContent is returned, with the content of the file; see
+// RepositoryContent.Decode.  If path is a directory, the entries it holds
+// are returned in directoryContent, without their content.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#get-contents
+func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (fileContent *RepositoryContent, directoryContent []RepositoryContent, resp *Response, err error) {
+	u := fmt.Sprintf("repos/%v/%v/contents/%v", owner, repo, path)
+	u, err = addOptions(u, opt)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var raw json.RawMessage
+	resp, err = s.client.Do(ctx, req, &raw)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	if err := json.Unmarshal(raw, &fileContent); err == nil {
+		return fileContent, nil, resp, nil
+	}
+	if err := json.Unmarshal(raw, &directoryContent); err != nil {
+		return nil, nil, resp, fmt.Errorf("github: unmarshalling contents of %v: %v", path, err)
+	}
+	return nil, directoryContent, resp, nil
+}
+
+// GetReadme gets the preferred README of a repository.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#get-the-readme
+func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) (*RepositoryContent, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/readme", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	readme := new(RepositoryContent)
+	resp, err := s.client.Do(ctx, req, readme)
+	return readme, resp, err
+}
+
+// DownloadContents returns a reader for the raw content of the file at path,
+// streamed as it is received rather than base64 encoded in JSON, so that it
+// also works for files too large for GetContents.  The caller must close the
+// returned reader.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#custom-media-types
+func (s *RepositoriesService) DownloadContents(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/contents/%v", owner, repo, path)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mimeRaw)
+
+	resp, err := s.client.DoStream(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+	return resp.Body, resp, nil
+}
+
+// CreateFile creates a new file in a repository, committing it to
+// opt.Branch.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#create-a-file
+func (s *RepositoriesService) CreateFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
+	return s.putFile(ctx, owner, repo, path, opt)
+}
+
+// UpdateFile replaces the content of a file in a repository.  opt.SHA must be
+// the blob SHA of the file being replaced.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#update-a-file
+func (s *RepositoriesService) UpdateFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
+	return s.putFile(ctx, owner, repo, path, opt)
+}
+
+// putFile creates or updates a file.
+func (s *RepositoriesService) putFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/contents/%v", owner, repo, path)
+	req, err := s.client.NewRequest("PUT", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(RepositoryContentResponse)
+	resp, err := s.client.Do(ctx, req, r)
+	return r, resp, err
+}
+
+// DeleteFile deletes a file from a repository.  opt.SHA must be the blob SHA
+// of the file being deleted.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#delete-a-file
+func (s *RepositoriesService) DeleteFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/contents/%v", owner, repo, path)
+	req, err := s.client.NewRequest("DELETE", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(RepositoryContentResponse)
+	resp, err := s.client.Do(ctx, req, r)
+	return r, resp, err
+}
+
+// ArchiveFormat is the format of a repository archive.
+type ArchiveFormat string
+
+const (
+	// Tarball is a gzipped tar archive.
+	Tarball ArchiveFormat = "tarball"
+
+	// Zipball is a zip archive.
+	Zipball ArchiveFormat = "zipball"
+)
+
+// GetArchiveLink returns the URL from which an archive of the repository at
+// ref can be downloaded.  The URL is temporary, and for private repositories
+// grants access without further authentication.  An empty ref selects the
+// default branch.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#get-archive-link
+func (s *RepositoriesService) GetArchiveLink(ctx context.Context, owner, repo string, format ArchiveFormat, ref string) (*url.URL, *Response, error) {
+	req, err := s.newArchiveRequest(owner, repo, format, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.DoStream(context.WithValue(ctx, noRedirectKey{}, true), req)
+	if resp == nil {
+		return nil, nil, err
+	}
+	if !isRedirect(resp.StatusCode) {
+		if err == nil {
+			resp.Body.Close()
+			err = fmt.Errorf("github: unexpected status %v getting archive link", resp.Status)
+		}
+		return nil, resp, err
+	}
+
+	// CheckResponse has read and closed the body of the redirect.
+	u, err := resp.Response.Location()
+	if err != nil {
+		return nil, resp, fmt.Errorf("github: getting archive link: %v", err)
+	}
+	return u, resp, nil
+}
+
+// DownloadArchive returns a reader for an archive of the repository at ref,
+// streamed as it is received.  GitHub redirects the download to the archive,
+// which is then fetched without the credentials of the client.  An empty ref
+// selects the default branch.  The caller must close the returned reader.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/contents/#get-archive-link
+func (s *RepositoriesService) DownloadArchive(ctx context.Context, owner, repo string, format ArchiveFormat, ref string) (io.ReadCloser, *Response, error) {
+	req, err := s.newArchiveRequest(owner, repo, format, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.client.download(ctx, req)
+}
+
+// newArchiveRequest returns the request for an archive of a repository.
+func (s *RepositoriesService) newArchiveRequest(owner, repo string, format ArchiveFormat, ref string) (*http.Request, error) {
+	if format != Tarball && format != Zipball {
+		return nil, fmt.Errorf("github: unsupported archive format %q", format)
+	}
+	u := fmt.Sprintf("repos/%v/%v/%v", owner, repo, format)
+	if ref != "" {
+		u += "/" + ref
+	}
+	return s.client.NewRequest("GET", u, nil)
+}
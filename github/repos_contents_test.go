@@ -0,0 +1,396 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoryContent_Decode(t *testing.T) {
+	tests := []struct {
+		encoding, content *string
+		want              string
+		wantErr           bool
+	}{
+		{String("base64"), String("aGVsbG8s\nIHdvcmxk\n"), "hello, world", false},
+		{nil, String("hello"), "hello", false},
+		{String("base64"), String("!"), "", true},
+		{String("gzip"), String("x"), "", true},
+		{String("base64"), nil, "", true},
+	}
+	for _, tt := range tests {
+		r := &RepositoryContent{Encoding: tt.encoding, Content: tt.content}
+		got, err := r.Decode()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Decode of %v returned error %v, want error %v", r, err, tt.wantErr)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Decode of %v returned %q, want %q", r, got, tt.want)
+		}
+	}
+}
+
+func TestRepositoriesService_GetContents_file(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p/f.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "b"})
+		fmt.Fprint(w, `{"type":"file","encoding":"base64","content":"eDogMQ==\n","sha":"s"}`)
+	})
+
+	file, dir, _, err := client.Repositories.GetContents(context.Background(), "o", "r", "p/f.yml", &RepositoryContentGetOptions{Ref: "b"})
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	if dir != nil {
+		t.Errorf("Repositories.GetContents returned directory %+v, want nil", dir)
+	}
+
+	want := &RepositoryContent{Type: String("file"), Encoding: String("base64"), Content: String("eDogMQ==\n"), SHA: String("s")}
+	if !reflect.DeepEqual(file, want) {
+		t.Errorf("Repositories.GetContents returned %+v, want %+v", file, want)
+	}
+	if b, _ := file.Decode(); string(b) != "x: 1" {
+		t.Errorf("Decode returned %q, want %q", b, "x: 1")
+	}
+}
+
+func TestRepositoriesService_GetContents_directory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"type":"file","name":"a"},{"type":"dir","name":"b"}]`)
+	})
+
+	file, dir, _, err := client.Repositories.GetContents(context.Background(), "o", "r", "p", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	if file != nil {
+		t.Errorf("Repositories.GetContents returned file %+v, want nil", file)
+	}
+
+	want := []RepositoryContent{
+		{Type: String("file"), Name: String("a")},
+		{Type: String("dir"), Name: String("b")},
+	}
+	if !reflect.DeepEqual(dir, want) {
+		t.Errorf("Repositories.GetContents returned %+v, want %+v", dir, want)
+	}
+}
+
+func TestRepositoriesService_GetContents_invalidOwner(t *testing.T) {
+	_, _, _, err := client.Repositories.GetContents(context.Background(), "%", "r", "p", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_GetReadme(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/readme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"README.md"}`)
+	})
+
+	readme, _, err := client.Repositories.GetReadme(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Repositories.GetReadme returned error: %v", err)
+	}
+
+	want := &RepositoryContent{Name: String("README.md")}
+	if !reflect.DeepEqual(readme, want) {
+		t.Errorf("Repositories.GetReadme returned %+v, want %+v", readme, want)
+	}
+}
+
+func TestRepositoriesService_DownloadContents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+		fmt.Fprint(w, "raw")
+	})
+
+	rc, _, err := client.Repositories.DownloadContents(context.Background(), "o", "r", "f", nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContents returned error: %v", err)
+	}
+	defer rc.Close()
+
+	b, _ := ioutil.ReadAll(rc)
+	if want := "raw"; string(b) != want {
+		t.Errorf("Repositories.DownloadContents returned %q, want %q", b, want)
+	}
+}
+
+func TestRepositoriesService_CreateFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &RepositoryContentFileOptions{
+		Message:   String("m"),
+		Content:   []byte("c"),
+		Branch:    String("b"),
+		Committer: &CommitAuthor{Name: String("n"), Email: String("e")},
+	}
+
+	mux.HandleFunc("/repos/o/r/contents/f", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		testMethod(t, r, "PUT")
+		if got, want := body["content"], "Yw=="; got != want {
+			t.Errorf("Request body content = %v, want %v", got, want)
+		}
+
+		fmt.Fprint(w, `{"content":{"name":"f"},"commit":{"sha":"s"}}`)
+	})
+
+	created, _, err := client.Repositories.CreateFile(context.Background(), "o", "r", "f", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateFile returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{
+		Content: &RepositoryContent{Name: String("f")},
+		Commit:  &Commit{SHA: String("s")},
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("Repositories.CreateFile returned %+v, want %+v", created, want)
+	}
+}
+
+func TestRepositoriesService_UpdateFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &RepositoryContentFileOptions{
+		Message: String("m"),
+		Content: []byte("c"),
+		SHA:     String("old"),
+	}
+
+	mux.HandleFunc("/repos/o/r/contents/f", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepositoryContentFileOptions)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"content":{"sha":"new"}}`)
+	})
+
+	updated, _, err := client.Repositories.UpdateFile(context.Background(), "o", "r", "f", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateFile returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{Content: &RepositoryContent{SHA: String("new")}}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("Repositories.UpdateFile returned %+v, want %+v", updated, want)
+	}
+}
+
+func TestRepositoriesService_UpdateFile_conflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/f", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"f does not match old"}`, http.StatusConflict)
+	})
+
+	_, resp, err := client.Repositories.UpdateFile(context.Background(), "o", "r", "f", &RepositoryContentFileOptions{SHA: String("old")})
+	if err == nil {
+		t.Fatal("Repositories.UpdateFile returned no error, want conflict")
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.UpdateFile returned status %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+}
+
+func TestRepositoriesService_DeleteFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &RepositoryContentFileOptions{Message: String("m"), SHA: String("s")}
+
+	mux.HandleFunc("/repos/o/r/contents/f", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepositoryContentFileOptions)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "DELETE")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"content":null,"commit":{"sha":"c"}}`)
+	})
+
+	deleted, _, err := client.Repositories.DeleteFile(context.Background(), "o", "r", "f", input)
+	if err != nil {
+		t.Errorf("Repositories.DeleteFile returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{Commit: &Commit{SHA: String("c")}}
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("Repositories.DeleteFile returned %+v, want %+v", deleted, want)
+	}
+}
+
+func TestRepositoriesService_GetArchiveLink(t *testing.T) {
+	for _, status := range []int{http.StatusFound, http.StatusTemporaryRedirect} {
+		setup()
+
+		mux.HandleFunc("/repos/o/r/tarball/v1", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			http.Redirect(w, r, "https://codeload.example.com/o/r/legacy.tar.gz/v1", status)
+		})
+
+		link, resp, err := client.Repositories.GetArchiveLink(context.Background(), "o", "r", Tarball, "v1")
+		if err != nil {
+			t.Fatalf("Repositories.GetArchiveLink returned error for status %d: %v", status, err)
+		}
+		if resp.StatusCode != status {
+			t.Errorf("Repositories.GetArchiveLink returned status %d, want %d", resp.StatusCode, status)
+		}
+		if want := "https://codeload.example.com/o/r/legacy.tar.gz/v1"; link.String() != want {
+			t.Errorf("Repositories.GetArchiveLink returned %v, want %v", link, want)
+		}
+
+		teardown()
+	}
+}
+
+func TestRepositoriesService_GetArchiveLink_noLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tarball", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusFound)
+	})
+
+	_, _, err := client.Repositories.GetArchiveLink(context.Background(), "o", "r", Tarball, "")
+	if err == nil {
+		t.Error("Repositories.GetArchiveLink returned no error for a redirect without Location")
+	}
+}
+
+func TestRepositoriesService_GetArchiveLink_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/zipball", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Repositories.GetArchiveLink(context.Background(), "o", "r", Zipball, "")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("Repositories.GetArchiveLink returned %v, want *NotFoundError", err)
+	}
+}
+
+func TestRepositoriesService_GetArchiveLink_invalidFormat(t *testing.T) {
+	_, _, err := client.Repositories.GetArchiveLink(context.Background(), "o", "r", "rar", "")
+	if err == nil {
+		t.Error("Repositories.GetArchiveLink returned no error for an invalid format")
+	}
+}
+
+func TestRepositoriesService_DownloadArchive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codeload := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if v := r.Header.Get("Authorization"); v != "" {
+			t.Errorf("Archive host received Authorization header %q", v)
+		}
+		fmt.Fprint(w, "PK")
+	}))
+	defer codeload.Close()
+
+	mux.HandleFunc("/repos/o/r/zipball/master", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		http.Redirect(w, r, codeload.URL+"/o/r/legacy.zip/master", http.StatusFound)
+	})
+
+	tp := &TokenAuthTransport{Token: "t"}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+
+	rc, _, err := authedClient.Repositories.DownloadArchive(context.Background(), "o", "r", Zipball, "master")
+	if err != nil {
+		t.Fatalf("Repositories.DownloadArchive returned error: %v", err)
+	}
+	defer rc.Close()
+
+	b, _ := ioutil.ReadAll(rc)
+	if want := "PK"; string(b) != want {
+		t.Errorf("Repositories.DownloadArchive returned %q, want %q", b, want)
+	}
+}
+
+func TestRepositoriesService_DownloadArchive_redirectTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codeload := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "PK")
+	}))
+	defer codeload.Close()
+
+	mux.HandleFunc("/repos/o/r/zipball", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, codeload.URL+"/o/r/legacy.zip", http.StatusTemporaryRedirect)
+	})
+
+	rt := new(recordingTransport)
+	tp := &TokenAuthTransport{Token: "t", Transport: rt}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+
+	rc, _, err := authedClient.Repositories.DownloadArchive(context.Background(), "o", "r", Zipball, "")
+	if err != nil {
+		t.Fatalf("Repositories.DownloadArchive returned error: %v", err)
+	}
+	rc.Close()
+
+	if len(rt.requests) != 2 {
+		t.Fatalf("Transport sent %d requests, want 2", len(rt.requests))
+	}
+	if v := rt.requests[1].Header.Get("Authorization"); v != "" {
+		t.Errorf("Archive request has Authorization header %q", v)
+	}
+}
+
+func TestRepositoriesService_DownloadArchive_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tarball", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Repositories.DownloadArchive(context.Background(), "o", "r", Tarball, "")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("Repositories.DownloadArchive returned %v, want *NotFoundError", err)
+	}
+}
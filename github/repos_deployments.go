@@ -0,0 +1,260 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Errors matched by the errors returned from
+// RepositoriesService.CreateDeployment, for use with errors.Is.
+var (
+	ErrMergeConflict         = errors.New("github: merge conflict")
+	ErrRequiredContextFailed = errors.New("github: required status contexts failed")
+)
+
+// MergeConflictError occurs when GitHub cannot create a deployment because
+// auto-merging the default branch into the deployed ref failed with a
+// conflict.  It is also a ConflictError for errors.Is.
+type MergeConflictError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *MergeConflictError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrMergeConflict or ErrConflict.
+func (e *MergeConflictError) Is(target error) bool {
+	return target == ErrMergeConflict || target == ErrConflict
+}
+
+// RequiredContextError occurs when GitHub refuses to create a deployment
+// because some of its required status contexts are not successful for the
+// deployed ref.  It is also a ValidationError for errors.Is.
+type RequiredContextError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *RequiredContextError) Unwrap() error { return e.ErrorResponse }
+
+// Is reports whether target is ErrRequiredContextFailed or ErrValidation.
+func (e *RequiredContextError) Is(target error) bool {
+	return target == ErrRequiredContextFailed || target == ErrValidation
+}
+
+// deploymentError returns the deployment specific error matching err, or err
+// itself if there is none.
+func deploymentError(err error) error {
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		return &MergeConflictError{conflict.ErrorResponse}
+	}
+	var validation *ValidationError
+	if errors.As(err, &validation) && isRequiredContextFailure(validation.ErrorResponse) {
+		return &RequiredContextError{validation.ErrorResponse}
+	}
+	return err
+}
+
+// isRequiredContextFailure reports whether the 422 Unprocessable Entity
+// response that caused e was sent because required contexts failed.
+func isRequiredContextFailure(e *ErrorResponse) bool {
+	for _, v := range e.Errors {
+		if v.Field == "required_contexts" {
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(e.Message), "status checks failed")
+}
+
+// Deployment represents a request to deploy a ref of a repository.
+type Deployment struct {
+	ID          *int            `json:"id,omitempty"`
+	SHA         *string         `json:"sha,omitempty"`
+	Ref         *string         `json:"ref,omitempty"`
+	Task        *string         `json:"task,omitempty"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	Environment *string         `json:"environment,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Creator     *User           `json:"creator,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time      `json:"updated_at,omitempty"`
+
+	URL           *string `json:"url,omitempty"`
+	StatusesURL   *string `json:"statuses_url,omitempty"`
+	RepositoryURL *string `json:"repository_url,omitempty"`
+}
+
+func (d Deployment) String() string {
+	return Stringify(d)
+}
+
+// DeploymentRequest represents a deployment to create.
+type DeploymentRequest struct {
+	// Ref is the branch, tag or SHA to deploy.  It is required.
+	Ref *string `json:"ref,omitempty"`
+
+	// Task is the task to execute, "deploy" by default.
+	Task *string `json:"task,omitempty"`
+
+	// AutoMerge merges the default branch into Ref before deploying if Ref
+	// is behind it.  GitHub defaults to true.
+	AutoMerge *bool `json:"auto_merge,omitempty"`
+
+	// RequiredContexts are the status contexts that must be successful for
+	// Ref.  GitHub defaults to all contexts; an empty slice bypasses the
+	// check.
+	RequiredContexts *[]string `json:"required_contexts,omitempty"`
+
+	// Payload holds extra information for the deployment system.  It is
+	// encoded as JSON.
+	Payload interface{} `json:"payload,omitempty"`
+
+	// Environment is the name of the target environment, "production" by
+	// default.
+	Environment *string `json:"environment,omitempty"`
+
+	Description *string `json:"description,omitempty"`
+}
+
+// DeploymentsListOptions specifies the optional parameters to the
+// RepositoriesService.ListDeployments method.
+type DeploymentsListOptions struct {
+	// SHA, Ref, Task and Environment filter deployments by the field of the
+	// same name.
+	SHA         string `url:"sha,omitempty"`
+	Ref         string `url:"ref,omitempty"`
+	Task        string `url:"task,omitempty"`
+	Environment string `url:"environment,omitempty"`
+
+	ListOptions
+}
+
+// DeploymentStatus represents the status of a deployment.
+type DeploymentStatus struct {
+	ID *int `json:"id,omitempty"`
+
+	// State is the state of the deployment.  Possible values are: pending,
+	// success, failure, or error.
+	State *string `json:"state,omitempty"`
+
+	// TargetURL is the URL of the output of the deployment.
+	TargetURL *string `json:"target_url,omitempty"`
+
+	Description *string    `json:"description,omitempty"`
+	Creator     *User      `json:"creator,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	DeploymentURL *string `json:"deployment_url,omitempty"`
+	RepositoryURL *string `json:"repository_url,omitempty"`
+}
+
+func (d DeploymentStatus) String() string {
+	return Stringify(d)
+}
+
+// DeploymentStatusRequest represents a deployment status to create.
+type DeploymentStatusRequest struct {
+	// State is required.  Possible values are: pending, success, failure,
+	// or error.
+	State       *string `json:"state,omitempty"`
+	TargetURL   *string `json:"target_url,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ListDeployments lists the deployments of a repository, newest first.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/deployments/#list-deployments
+func (s *RepositoriesService) ListDeployments(ctx context.Context, owner, repo string, opt *DeploymentsListOptions) ([]Deployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/deployments", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployments := new([]Deployment)
+	resp, err := s.client.Do(ctx, req, deployments)
+	return *deployments, resp, err
+}
+
+// GetDeployment gets a single deployment.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/deployments/#get-a-single-deployment
+func (s *RepositoriesService) GetDeployment(ctx context.Context, owner, repo string, id int) (*Deployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/deployments/%d", owner, repo, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(Deployment)
+	resp, err := s.client.Do(ctx, req, d)
+	return d, resp, err
+}
+
+// CreateDeployment creates a deployment.  If auto-merging the default branch
+// fails, a *MergeConflictError is returned; if required contexts are not
+// successful, a *RequiredContextError is returned.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/deployments/#create-a-deployment
+func (s *RepositoriesService) CreateDeployment(ctx context.Context, owner, repo string, request *DeploymentRequest) (*Deployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/deployments", owner, repo)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(Deployment)
+	resp, err := s.client.Do(ctx, req, d)
+	return d, resp, deploymentError(err)
+}
+
+// ListDeploymentStatuses lists the statuses of a deployment, newest first.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/deployments/#list-deployment-statuses
+func (s *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner, repo string, deployment int, opt *ListOptions) ([]DeploymentStatus, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/deployments/%d/statuses", owner, repo, deployment)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := new([]DeploymentStatus)
+	resp, err := s.client.Do(ctx, req, statuses)
+	return *statuses, resp, err
+}
+
+// CreateDeploymentStatus creates a status for a deployment.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/deployments/#create-a-deployment-status
+func (s *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner, repo string, deployment int, request *DeploymentStatusRequest) (*DeploymentStatus, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/deployments/%d/statuses", owner, repo, deployment)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(DeploymentStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	return status, resp, err
+}
@@ -0,0 +1,207 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListDeployments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "master", "environment": "staging", "page": "2"})
+		fmt.Fprint(w, `[{"id":1,"payload":{"a":1}}]`)
+	})
+
+	opt := &DeploymentsListOptions{Ref: "master", Environment: "staging", ListOptions: ListOptions{Page: 2}}
+	deployments, _, err := client.Repositories.ListDeployments(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListDeployments returned error: %v", err)
+	}
+
+	want := []Deployment{{ID: Int(1), Payload: json.RawMessage(`{"a":1}`)}}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Repositories.ListDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
+func TestRepositoriesService_ListDeployments_invalidOwner(t *testing.T) {
+	_, _, err := client.Repositories.ListDeployments(context.Background(), "%", "r", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_GetDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"ref":"master"}`)
+	})
+
+	deployment, _, err := client.Repositories.GetDeployment(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetDeployment returned error: %v", err)
+	}
+
+	want := &Deployment{ID: Int(1), Ref: String("master")}
+	if !reflect.DeepEqual(deployment, want) {
+		t.Errorf("Repositories.GetDeployment returned %+v, want %+v", deployment, want)
+	}
+}
+
+func TestRepositoriesService_CreateDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &DeploymentRequest{
+		Ref:              String("topic"),
+		AutoMerge:        Bool(false),
+		RequiredContexts: &[]string{},
+		Payload:          map[string]string{"deploy": "migrate"},
+		Environment:      String("staging"),
+	}
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		testMethod(t, r, "POST")
+		want := map[string]interface{}{
+			"ref":               "topic",
+			"auto_merge":        false,
+			"required_contexts": []interface{}{},
+			"payload":           map[string]interface{}{"deploy": "migrate"},
+			"environment":       "staging",
+		}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	deployment, _, err := client.Repositories.CreateDeployment(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateDeployment returned error: %v", err)
+	}
+
+	want := &Deployment{ID: Int(1)}
+	if !reflect.DeepEqual(deployment, want) {
+		t.Errorf("Repositories.CreateDeployment returned %+v, want %+v", deployment, want)
+	}
+}
+
+func TestRepositoriesService_CreateDeployment_mergeConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Conflict merging master into topic."}`, http.StatusConflict)
+	})
+
+	_, _, err := client.Repositories.CreateDeployment(context.Background(), "o", "r", &DeploymentRequest{Ref: String("topic")})
+	if _, ok := err.(*MergeConflictError); !ok {
+		t.Fatalf("Repositories.CreateDeployment returned %v, want *MergeConflictError", err)
+	}
+	if !errors.Is(err, ErrMergeConflict) || !errors.Is(err, ErrConflict) {
+		t.Errorf("errors.Is(%v, ErrMergeConflict/ErrConflict) = false, want true", err)
+	}
+}
+
+func TestRepositoriesService_CreateDeployment_requiredContextFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(422)
+		fmt.Fprint(w, `{
+			"message": "Conflict: Commit status checks failed for topic.",
+			"errors": [{"resource": "Deployment", "field": "required_contexts", "code": "invalid"}]
+		}`)
+	})
+
+	_, _, err := client.Repositories.CreateDeployment(context.Background(), "o", "r", &DeploymentRequest{Ref: String("topic")})
+	if _, ok := err.(*RequiredContextError); !ok {
+		t.Fatalf("Repositories.CreateDeployment returned %v, want *RequiredContextError", err)
+	}
+	if !errors.Is(err, ErrRequiredContextFailed) || !errors.Is(err, ErrValidation) {
+		t.Errorf("errors.Is(%v, ErrRequiredContextFailed/ErrValidation) = false, want true", err)
+	}
+}
+
+func TestRepositoriesService_CreateDeployment_validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(422)
+		fmt.Fprint(w, `{"message":"No ref found for: nope"}`)
+	})
+
+	_, _, err := client.Repositories.CreateDeployment(context.Background(), "o", "r", &DeploymentRequest{Ref: String("nope")})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("Repositories.CreateDeployment returned %v, want *ValidationError", err)
+	}
+}
+
+func TestRepositoriesService_ListDeploymentStatuses(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"state":"success"}]`)
+	})
+
+	statuses, _, err := client.Repositories.ListDeploymentStatuses(context.Background(), "o", "r", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListDeploymentStatuses returned error: %v", err)
+	}
+
+	want := []DeploymentStatus{{ID: Int(1), State: String("success")}}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Repositories.ListDeploymentStatuses returned %+v, want %+v", statuses, want)
+	}
+}
+
+func TestRepositoriesService_CreateDeploymentStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &DeploymentStatusRequest{State: String("failure"), TargetURL: String("t")}
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DeploymentStatusRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	status, _, err := client.Repositories.CreateDeploymentStatus(context.Background(), "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.CreateDeploymentStatus returned error: %v", err)
+	}
+
+	want := &DeploymentStatus{ID: Int(1)}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Repositories.CreateDeploymentStatus returned %+v, want %+v", status, want)
+	}
+}
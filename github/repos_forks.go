@@ -6,8 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
 )
 
 // RepositoryListForksOptions specifies the optional parameters to the
@@ -15,19 +15,19 @@ import (
 type RepositoryListForksOptions struct {
 	// How to sort the forks list.  Possible values are: newest, oldest,
 	// watchers.  Default is "newest".
-	Sort string
+	Sort string `url:"sort,omitempty"`
+
+	ListOptions
 }
 
 // ListForks lists the forks of the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/forks/#list-forks
-func (s *RepositoriesService) ListForks(owner, repo string, opt *RepositoryListForksOptions) ([]Repository, *Response, error) {
+func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string, opt *RepositoryListForksOptions) ([]Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"sort": []string{opt.Sort},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -36,7 +36,7 @@ func (s *RepositoriesService) ListForks(owner, repo string, opt *RepositoryListF
 	}
 
 	repos := new([]Repository)
-	resp, err := s.client.Do(req, repos)
+	resp, err := s.client.Do(ctx, req, repos)
 	return *repos, resp, err
 }
 
@@ -44,19 +44,17 @@ func (s *RepositoriesService) ListForks(owner, repo string, opt *RepositoryListF
 // RepositoriesService.CreateFork method.
 type RepositoryCreateForkOptions struct {
 	// The organization to fork the repository into.
-	Organization string
+	Organization string `url:"organization,omitempty"`
 }
 
 // CreateFork creates a fork of the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/forks/#list-forks
-func (s *RepositoriesService) CreateFork(owner, repo string, opt *RepositoryCreateForkOptions) (*Repository, *Response, error) {
+func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string, opt *RepositoryCreateForkOptions) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
-	if opt != nil {
-		params := url.Values{
-			"organization": []string{opt.Organization},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("POST", u, nil)
@@ -65,6 +63,6 @@ func (s *RepositoriesService) CreateFork(owner, repo string, opt *RepositoryCrea
 	}
 
 	fork := new(Repository)
-	resp, err := s.client.Do(req, fork)
+	resp, err := s.client.Do(ctx, req, fork)
 	return fork, resp, err
 }
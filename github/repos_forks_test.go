@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -23,7 +24,7 @@ func TestRepositoriesService_ListForks(t *testing.T) {
 	})
 
 	opt := &RepositoryListForksOptions{Sort: "newest"}
-	repos, _, err := client.Repositories.ListForks("o", "r", opt)
+	repos, _, err := client.Repositories.ListForks(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListForks returned error: %v", err)
 	}
@@ -35,7 +36,7 @@ func TestRepositoriesService_ListForks(t *testing.T) {
 }
 
 func TestRepositoriesService_ListForks_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.ListForks("%", "r", nil)
+	_, _, err := client.Repositories.ListForks(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -50,7 +51,7 @@ func TestRepositoriesService_CreateFork(t *testing.T) {
 	})
 
 	opt := &RepositoryCreateForkOptions{Organization: "o"}
-	repo, _, err := client.Repositories.CreateFork("o", "r", opt)
+	repo, _, err := client.Repositories.CreateFork(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.CreateFork returned error: %v", err)
 	}
@@ -62,6 +63,6 @@ func TestRepositoriesService_CreateFork(t *testing.T) {
 }
 
 func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.CreateFork("%", "r", nil)
+	_, _, err := client.Repositories.CreateFork(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
@@ -6,9 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -16,11 +15,13 @@ import (
 // event hook is triggered.  The format of these payloads pre-date most of the
 // GitHub v3 API, so there are lots of minor incompatibilities with the types
 // defined in the rest of the API.  Therefore, several types are duplicated
-// here to account for these differences.
+// here to account for these differences.  WebHookHandler passes push events
+// to callbacks as a WebHookPayload.
 //
 // GitHub API docs: https://help.github.com/articles/post-receive-hooks
 type WebHookPayload struct {
 	After      *string         `json:"after,omitempty"`
+	BaseRef    *string         `json:"base_ref,omitempty"`
 	Before     *string         `json:"before,omitempty"`
 	Commits    []WebHookCommit `json:"commits,omitempty"`
 	Compare    *string         `json:"compare,omitempty"`
@@ -31,6 +32,7 @@ type WebHookPayload struct {
 	Pusher     *User           `json:"pusher,omitempty"`
 	Ref        *string         `json:"ref,omitempty"`
 	Repo       *Repository     `json:"repository,omitempty"`
+	Sender     *User           `json:"sender,omitempty"`
 }
 
 func (w WebHookPayload) String() string {
@@ -86,28 +88,25 @@ func (h Hook) String() string {
 // Name and Config are required fields.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#create-a-hook
-func (s *RepositoriesService) CreateHook(owner, repo string, hook *Hook) (*Hook, *Response, error) {
+func (s *RepositoriesService) CreateHook(ctx context.Context, owner, repo string, hook *Hook) (*Hook, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
 	req, err := s.client.NewRequest("POST", u, hook)
 	if err != nil {
 		return nil, nil, err
 	}
 	h := new(Hook)
-	resp, err := s.client.Do(req, h)
+	resp, err := s.client.Do(ctx, req, h)
 	return h, resp, err
 }
 
 // ListHooks lists all Hooks for the specified repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#list
-func (s *RepositoriesService) ListHooks(owner, repo string, opt *ListOptions) ([]Hook, *Response, error) {
+func (s *RepositoriesService) ListHooks(ctx context.Context, owner, repo string, opt *ListOptions) ([]Hook, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
-
-	if opt != nil {
-		params := url.Values{
-			"page": []string{strconv.Itoa(opt.Page)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -116,58 +115,58 @@ func (s *RepositoriesService) ListHooks(owner, repo string, opt *ListOptions) ([
 	}
 
 	hooks := new([]Hook)
-	resp, err := s.client.Do(req, hooks)
+	resp, err := s.client.Do(ctx, req, hooks)
 	return *hooks, resp, err
 }
 
 // GetHook returns a single specified Hook.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#get-single-hook
-func (s *RepositoriesService) GetHook(owner, repo string, id int) (*Hook, *Response, error) {
+func (s *RepositoriesService) GetHook(ctx context.Context, owner, repo string, id int) (*Hook, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	hook := new(Hook)
-	resp, err := s.client.Do(req, hook)
+	resp, err := s.client.Do(ctx, req, hook)
 	return hook, resp, err
 }
 
 // EditHook updates a specified Hook.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#edit-a-hook
-func (s *RepositoriesService) EditHook(owner, repo string, id int, hook *Hook) (*Hook, *Response, error) {
+func (s *RepositoriesService) EditHook(ctx context.Context, owner, repo string, id int, hook *Hook) (*Hook, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
 	req, err := s.client.NewRequest("PATCH", u, hook)
 	if err != nil {
 		return nil, nil, err
 	}
 	h := new(Hook)
-	resp, err := s.client.Do(req, h)
+	resp, err := s.client.Do(ctx, req, h)
 	return h, resp, err
 }
 
 // DeleteHook deletes a specified Hook.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#delete-a-hook
-func (s *RepositoriesService) DeleteHook(owner, repo string, id int) (*Response, error) {
+func (s *RepositoriesService) DeleteHook(ctx context.Context, owner, repo string, id int) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // TestHook triggers a test Hook by github.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/hooks/#test-a-push-hook
-func (s *RepositoriesService) TestHook(owner, repo string, id int) (*Response, error) {
+func (s *RepositoriesService) TestHook(ctx context.Context, owner, repo string, id int) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/hooks/%d/tests", owner, repo, id)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
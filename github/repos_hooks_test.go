@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -31,7 +32,7 @@ func TestRepositoriesService_CreateHook(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	hook, _, err := client.Repositories.CreateHook("o", "r", input)
+	hook, _, err := client.Repositories.CreateHook(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("Repositories.CreateHook returned error: %v", err)
 	}
@@ -52,9 +53,9 @@ func TestRepositoriesService_ListHooks(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
 	})
 
-	opt := &ListOptions{2}
+	opt := &ListOptions{Page: 2}
 
-	hooks, _, err := client.Repositories.ListHooks("o", "r", opt)
+	hooks, _, err := client.Repositories.ListHooks(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListHooks returned error: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestRepositoriesService_GetHook(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	hook, _, err := client.Repositories.GetHook("o", "r", 1)
+	hook, _, err := client.Repositories.GetHook(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.GetHook returned error: %v", err)
 	}
@@ -103,7 +104,7 @@ func TestRepositoriesService_EditHook(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	hook, _, err := client.Repositories.EditHook("o", "r", 1, input)
+	hook, _, err := client.Repositories.EditHook(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Repositories.EditHook returned error: %v", err)
 	}
@@ -122,7 +123,7 @@ func TestRepositoriesService_DeleteHook(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Repositories.DeleteHook("o", "r", 1)
+	_, err := client.Repositories.DeleteHook(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.DeleteHook returned error: %v", err)
 	}
@@ -136,7 +137,7 @@ func TestRepositoriesService_TestHook(t *testing.T) {
 		testMethod(t, r, "POST")
 	})
 
-	_, err := client.Repositories.TestHook("o", "r", 1)
+	_, err := client.Repositories.TestHook(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.TestHook returned error: %v", err)
 	}
@@ -5,15 +5,22 @@
 
 package github
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // The Key type is defined in users_keys.go
 
 // ListKeys lists the deploy keys for a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#list
-func (s *RepositoriesService) ListKeys(owner string, repo string) ([]Key, *Response, error) {
+func (s *RepositoriesService) ListKeys(ctx context.Context, owner string, repo string, opt *ListOptions) ([]Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -21,14 +28,14 @@ func (s *RepositoriesService) ListKeys(owner string, repo string) ([]Key, *Respo
 	}
 
 	keys := new([]Key)
-	resp, err := s.client.Do(req, keys)
+	resp, err := s.client.Do(ctx, req, keys)
 	return *keys, resp, err
 }
 
 // GetKey fetches a single deploy key.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#get
-func (s *RepositoriesService) GetKey(owner string, repo string, id int) (*Key, *Response, error) {
+func (s *RepositoriesService) GetKey(ctx context.Context, owner string, repo string, id int) (*Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -37,14 +44,14 @@ func (s *RepositoriesService) GetKey(owner string, repo string, id int) (*Key, *
 	}
 
 	key := new(Key)
-	resp, err := s.client.Do(req, key)
+	resp, err := s.client.Do(ctx, req, key)
 	return key, resp, err
 }
 
 // CreateKey adds a deploy key for a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#create
-func (s *RepositoriesService) CreateKey(owner string, repo string, key *Key) (*Key, *Response, error) {
+func (s *RepositoriesService) CreateKey(ctx context.Context, owner string, repo string, key *Key) (*Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
 
 	req, err := s.client.NewRequest("POST", u, key)
@@ -53,14 +60,14 @@ func (s *RepositoriesService) CreateKey(owner string, repo string, key *Key) (*K
 	}
 
 	k := new(Key)
-	resp, err := s.client.Do(req, k)
+	resp, err := s.client.Do(ctx, req, k)
 	return k, resp, err
 }
 
 // EditKey edits a deploy key.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#edit
-func (s *RepositoriesService) EditKey(owner string, repo string, id int, key *Key) (*Key, *Response, error) {
+func (s *RepositoriesService) EditKey(ctx context.Context, owner string, repo string, id int, key *Key) (*Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
 
 	req, err := s.client.NewRequest("PATCH", u, key)
@@ -69,14 +76,14 @@ func (s *RepositoriesService) EditKey(owner string, repo string, id int, key *Ke
 	}
 
 	k := new(Key)
-	resp, err := s.client.Do(req, k)
+	resp, err := s.client.Do(ctx, req, k)
 	return k, resp, err
 }
 
 // DeleteKey deletes a deploy key.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#delete
-func (s *RepositoriesService) DeleteKey(owner string, repo string, id int) (*Response, error) {
+func (s *RepositoriesService) DeleteKey(ctx context.Context, owner string, repo string, id int) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -84,5 +91,5 @@ func (s *RepositoriesService) DeleteKey(owner string, repo string, id int) (*Res
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestRepositoriesService_ListKeys(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	keys, _, err := client.Repositories.ListKeys("o", "r")
+	opt := &ListOptions{Page: 2}
+	keys, _, err := client.Repositories.ListKeys(context.Background(), "o", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListKeys returned error: %v", err)
 	}
@@ -34,7 +37,7 @@ func TestRepositoriesService_ListKeys(t *testing.T) {
 }
 
 func TestRepositoriesService_ListKeys_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.ListKeys("%", "%")
+	_, _, err := client.Repositories.ListKeys(context.Background(), "%", "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -47,7 +50,7 @@ func TestRepositoriesService_GetKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Repositories.GetKey("o", "r", 1)
+	key, _, err := client.Repositories.GetKey(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.GetKey returned error: %v", err)
 	}
@@ -76,7 +79,7 @@ func TestRepositoriesService_CreateKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Repositories.CreateKey("o", "r", input)
+	key, _, err := client.Repositories.CreateKey(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("Repositories.GetKey returned error: %v", err)
 	}
@@ -105,7 +108,7 @@ func TestRepositoriesService_EditKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Repositories.EditKey("o", "r", 1, input)
+	key, _, err := client.Repositories.EditKey(context.Background(), "o", "r", 1, input)
 	if err != nil {
 		t.Errorf("Repositories.EditKey returned error: %v", err)
 	}
@@ -124,7 +127,7 @@ func TestRepositoriesService_DeleteKey(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Repositories.DeleteKey("o", "r", 1)
+	_, err := client.Repositories.DeleteKey(context.Background(), "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.DeleteKey returned error: %v", err)
 	}
@@ -0,0 +1,308 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// RepositoryRelease represents a GitHub release in a repository.
+type RepositoryRelease struct {
+	ID              *int           `json:"id,omitempty"`
+	TagName         *string        `json:"tag_name,omitempty"`
+	TargetCommitish *string        `json:"target_commitish,omitempty"`
+	Name            *string        `json:"name,omitempty"`
+	Body            *string        `json:"body,omitempty"`
+	Draft           *bool          `json:"draft,omitempty"`
+	Prerelease      *bool          `json:"prerelease,omitempty"`
+	CreatedAt       *time.Time     `json:"created_at,omitempty"`
+	PublishedAt     *time.Time     `json:"published_at,omitempty"`
+	URL             *string        `json:"url,omitempty"`
+	HTMLURL         *string        `json:"html_url,omitempty"`
+	AssetsURL       *string        `json:"assets_url,omitempty"`
+	UploadURL       *string        `json:"upload_url,omitempty"`
+	TarballURL      *string        `json:"tarball_url,omitempty"`
+	ZipballURL      *string        `json:"zipball_url,omitempty"`
+	Author          *User          `json:"author,omitempty"`
+	Assets          []ReleaseAsset `json:"assets,omitempty"`
+}
+
+func (r RepositoryRelease) String() string {
+	return Stringify(r)
+}
+
+// ReleaseAsset represents a file attached to a GitHub release.
+type ReleaseAsset struct {
+	ID                 *int       `json:"id,omitempty"`
+	URL                *string    `json:"url,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	Label              *string    `json:"label,omitempty"`
+	State              *string    `json:"state,omitempty"`
+	ContentType        *string    `json:"content_type,omitempty"`
+	Size               *int       `json:"size,omitempty"`
+	DownloadCount      *int       `json:"download_count,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+	BrowserDownloadURL *string    `json:"browser_download_url,omitempty"`
+	Uploader           *User      `json:"uploader,omitempty"`
+}
+
+func (r ReleaseAsset) String() string {
+	return Stringify(r)
+}
+
+// UploadOptions specifies the parameters to the
+// RepositoriesService.UploadReleaseAsset methods.
+type UploadOptions struct {
+	// Name is the file name of the asset.  It is required.
+	Name string `url:"name,omitempty"`
+
+	// Label is a short description shown instead of Name.
+	Label string `url:"label,omitempty"`
+}
+
+// ListReleases lists the releases of a repository, including drafts if the
+// user has push access.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#list-releases-for-a-repository
+func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo string, opt *ListOptions) ([]RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releases := new([]RepositoryRelease)
+	resp, err := s.client.Do(ctx, req, releases)
+	return *releases, resp, err
+}
+
+// GetRelease gets a single release.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#get-a-single-release
+func (s *RepositoriesService) GetRelease(ctx context.Context, owner, repo string, id int) (*RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%d", owner, repo, id)
+	return s.getRelease(ctx, u)
+}
+
+// GetLatestRelease gets the latest published release, which is neither a
+// draft nor a prerelease.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#get-the-latest-release
+func (s *RepositoriesService) GetLatestRelease(ctx context.Context, owner, repo string) (*RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/latest", owner, repo)
+	return s.getRelease(ctx, u)
+}
+
+// GetReleaseByTag gets the release of a tag.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#get-a-release-by-tag-name
+func (s *RepositoriesService) GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/tags/%v", owner, repo, tag)
+	return s.getRelease(ctx, u)
+}
+
+// getRelease gets the release at urlStr.
+func (s *RepositoriesService) getRelease(ctx context.Context, urlStr string) (*RepositoryRelease, *Response, error) {
+	req, err := s.client.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(RepositoryRelease)
+	resp, err := s.client.Do(ctx, req, release)
+	return release, resp, err
+}
+
+// CreateRelease creates a release.  The tag is created from
+// TargetCommitish if it does not exist yet.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#create-a-release
+func (s *RepositoriesService) CreateRelease(ctx context.Context, owner, repo string, release *RepositoryRelease) (*RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases", owner, repo)
+	req, err := s.client.NewRequest("POST", u, release)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RepositoryRelease)
+	resp, err := s.client.Do(ctx, req, r)
+	return r, resp, err
+}
+
+// EditRelease edits a release.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#edit-a-release
+func (s *RepositoriesService) EditRelease(ctx context.Context, owner, repo string, id int, release *RepositoryRelease) (*RepositoryRelease, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%d", owner, repo, id)
+	req, err := s.client.NewRequest("PATCH", u, release)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RepositoryRelease)
+	resp, err := s.client.Do(ctx, req, r)
+	return r, resp, err
+}
+
+// DeleteRelease deletes a release.  The tag is kept.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#delete-a-release
+func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo string, id int) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%d", owner, repo, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListReleaseAssets lists the assets of a release.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#list-assets-for-a-release
+func (s *RepositoriesService) ListReleaseAssets(ctx context.Context, owner, repo string, id int, opt *ListOptions) ([]ReleaseAsset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%d/assets", owner, repo, id)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assets := new([]ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, assets)
+	return *assets, resp, err
+}
+
+// GetReleaseAsset gets a single release asset.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#get-a-single-release-asset
+func (s *RepositoriesService) GetReleaseAsset(ctx context.Context, owner, repo string, id int) (*ReleaseAsset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/assets/%d", owner, repo, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asset := new(ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, asset)
+	return asset, resp, err
+}
+
+// DownloadReleaseAsset returns a reader for the content of a release asset,
+// streamed as it is received.  GitHub redirects the download to the storage
+// holding the asset, which is then fetched without the credentials of the
+// client.  The caller must close the returned reader.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#get-a-single-release-asset
+func (s *RepositoriesService) DownloadReleaseAsset(ctx context.Context, owner, repo string, id int) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/assets/%d", owner, repo, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	return s.client.download(ctx, req)
+}
+
+// EditReleaseAsset edits the name and label of a release asset.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#edit-a-release-asset
+func (s *RepositoriesService) EditReleaseAsset(ctx context.Context, owner, repo string, id int, asset *ReleaseAsset) (*ReleaseAsset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/assets/%d", owner, repo, id)
+	req, err := s.client.NewRequest("PATCH", u, asset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, a)
+	return a, resp, err
+}
+
+// DeleteReleaseAsset deletes a release asset.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#delete-a-release-asset
+func (s *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner, repo string, id int) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/assets/%d", owner, repo, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UploadReleaseAsset uploads file as an asset of the release with the given
+// id.  The asset is read from the current offset of file to its end, so a
+// file that has already been read from must be rewound first.  opt.Name
+// defaults to the base name of file.  The Content-Type is guessed from the
+// extension of the name, and defaults to application/octet-stream.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#upload-a-release-asset
+func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, repo string, id int, opt *UploadOptions, file *os.File) (*ReleaseAsset, *Response, error) {
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if stat.IsDir() {
+		return nil, nil, errors.New("github: cannot upload a directory as a release asset")
+	}
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := UploadOptions{Name: filepath.Base(file.Name())}
+	if opt != nil {
+		o = *opt
+		if o.Name == "" {
+			o.Name = filepath.Base(file.Name())
+		}
+	}
+	mediaType := mime.TypeByExtension(filepath.Ext(o.Name))
+	return s.UploadReleaseAssetFromReader(ctx, owner, repo, id, &o, file, stat.Size()-offset, mediaType)
+}
+
+// UploadReleaseAssetFromReader uploads the size bytes read from r as an asset
+// of the release with the given id, with the given media type as its
+// Content-Type.  opt.Name is required.  An empty mediaType defaults to
+// application/octet-stream.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/releases/#upload-a-release-asset
+func (s *RepositoriesService) UploadReleaseAssetFromReader(ctx context.Context, owner, repo string, id int, opt *UploadOptions, r io.Reader, size int64, mediaType string) (*ReleaseAsset, *Response, error) {
+	if opt == nil || opt.Name == "" {
+		return nil, nil, errors.New("github: release asset name is required")
+	}
+	u := fmt.Sprintf("repos/%v/%v/releases/%d/assets", owner, repo, id)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewUploadRequest(u, r, size, mediaType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asset := new(ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, asset)
+	return asset, resp, err
+}
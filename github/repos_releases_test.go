@@ -0,0 +1,495 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRepositoriesService_ListReleases(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	releases, _, err := client.Repositories.ListReleases(context.Background(), "o", "r", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListReleases returned error: %v", err)
+	}
+
+	want := []RepositoryRelease{{ID: Int(1)}}
+	if !reflect.DeepEqual(releases, want) {
+		t.Errorf("Repositories.ListReleases returned %+v, want %+v", releases, want)
+	}
+}
+
+func TestRepositoriesService_ListReleases_invalidOwner(t *testing.T) {
+	_, _, err := client.Repositories.ListReleases(context.Background(), "%", "r", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_GetRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"author":{"login":"l"}}`)
+	})
+
+	release, _, err := client.Repositories.GetRelease(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetRelease returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{ID: Int(1), Author: &User{Login: String("l")}}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.GetRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_GetLatestRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3}`)
+	})
+
+	release, _, err := client.Repositories.GetLatestRelease(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetLatestRelease returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{ID: Int(3)}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.GetLatestRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_GetReleaseByTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/tags/v1.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":13,"tag_name":"v1.0"}`)
+	})
+
+	release, _, err := client.Repositories.GetReleaseByTag(context.Background(), "o", "r", "v1.0")
+	if err != nil {
+		t.Errorf("Repositories.GetReleaseByTag returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{ID: Int(13), TagName: String("v1.0")}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.GetReleaseByTag returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_CreateRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &RepositoryRelease{TagName: String("v1.0"), Draft: Bool(true)}
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepositoryRelease)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	release, _, err := client.Repositories.CreateRelease(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateRelease returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{ID: Int(1)}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.CreateRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_EditRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &RepositoryRelease{Name: String("n"), Draft: Bool(false)}
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepositoryRelease)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	release, _, err := client.Repositories.EditRelease(context.Background(), "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.EditRelease returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{ID: Int(1)}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.EditRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_DeleteRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Repositories.DeleteRelease(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.DeleteRelease returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_ListReleaseAssets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	assets, _, err := client.Repositories.ListReleaseAssets(context.Background(), "o", "r", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListReleaseAssets returned error: %v", err)
+	}
+
+	want := []ReleaseAsset{{ID: Int(1)}}
+	if !reflect.DeepEqual(assets, want) {
+		t.Errorf("Repositories.ListReleaseAssets returned %+v, want %+v", assets, want)
+	}
+}
+
+func TestRepositoriesService_GetReleaseAsset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"browser_download_url":"u"}`)
+	})
+
+	asset, _, err := client.Repositories.GetReleaseAsset(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetReleaseAsset returned error: %v", err)
+	}
+
+	want := &ReleaseAsset{ID: Int(1), BrowserDownloadURL: String("u")}
+	if !reflect.DeepEqual(asset, want) {
+		t.Errorf("Repositories.GetReleaseAsset returned %+v, want %+v", asset, want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAsset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/octet-stream")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, "binary")
+	})
+
+	rc, _, err := client.Repositories.DownloadReleaseAsset(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAsset returned error: %v", err)
+	}
+	defer rc.Close()
+
+	b, _ := ioutil.ReadAll(rc)
+	if want := "binary"; string(b) != want {
+		t.Errorf("Repositories.DownloadReleaseAsset returned %q, want %q", b, want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAsset_redirect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Authorization"); v != "" {
+			t.Errorf("Storage host received Authorization header %q", v)
+		}
+		testFormValues(t, r, values{"signature": "s"})
+		fmt.Fprint(w, "binary")
+	}))
+	defer storage.Close()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		http.Redirect(w, r, storage.URL+"/asset?signature=s", http.StatusFound)
+	})
+
+	tp := &TokenAuthTransport{Token: "t"}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+
+	rc, resp, err := authedClient.Repositories.DownloadReleaseAsset(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAsset returned error: %v", err)
+	}
+	defer rc.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("Repositories.DownloadReleaseAsset returned status %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+	b, _ := ioutil.ReadAll(rc)
+	if want := "binary"; string(b) != want {
+		t.Errorf("Repositories.DownloadReleaseAsset returned %q, want %q", b, want)
+	}
+}
+
+// recordingTransport records the requests it sends through
+// http.DefaultTransport.
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRepositoriesService_DownloadReleaseAsset_redirectTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "binary")
+	}))
+	defer storage.Close()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/asset", http.StatusFound)
+	})
+
+	rt := new(recordingTransport)
+	tp := &TokenAuthTransport{Token: "t", Transport: rt}
+	authedClient := NewClient(tp.Client())
+	authedClient.BaseURL = client.BaseURL
+
+	rc, _, err := authedClient.Repositories.DownloadReleaseAsset(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAsset returned error: %v", err)
+	}
+	rc.Close()
+
+	if len(rt.requests) != 2 {
+		t.Fatalf("Transport sent %d requests, want 2", len(rt.requests))
+	}
+	storageReq := rt.requests[1]
+	if want := strings.TrimPrefix(storage.URL, "http://"); storageReq.URL.Host != want {
+		t.Errorf("Second request sent to %v, want %v", storageReq.URL.Host, want)
+	}
+	if v := storageReq.Header.Get("Authorization"); v != "" {
+		t.Errorf("Storage request has Authorization header %q", v)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAsset_storageError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "<Error>AccessDenied</Error>", http.StatusForbidden)
+	}))
+	defer storage.Close()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/asset", http.StatusFound)
+	})
+
+	_, _, err := client.Repositories.DownloadReleaseAsset(context.Background(), "o", "r", 1)
+	if err == nil {
+		t.Error("Repositories.DownloadReleaseAsset returned no error for a failed download")
+	}
+}
+
+func TestRepositoriesService_EditReleaseAsset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ReleaseAsset{Name: String("n"), Label: String("l")}
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ReleaseAsset)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	asset, _, err := client.Repositories.EditReleaseAsset(context.Background(), "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.EditReleaseAsset returned error: %v", err)
+	}
+
+	want := &ReleaseAsset{ID: Int(1)}
+	if !reflect.DeepEqual(asset, want) {
+		t.Errorf("Repositories.EditReleaseAsset returned %+v, want %+v", asset, want)
+	}
+}
+
+func TestRepositoriesService_DeleteReleaseAsset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Repositories.DeleteReleaseAsset(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.DeleteReleaseAsset returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "go-github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "notes.txt")
+	if err := ioutil.WriteFile(name, []byte("upload"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"name": "notes.txt", "label": "l"})
+		if got := r.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/plain") {
+			t.Errorf("Content-Type = %v, want text/plain", got)
+		}
+		if r.ContentLength != 6 {
+			t.Errorf("Content-Length = %v, want 6", r.ContentLength)
+		}
+		if b, _ := ioutil.ReadAll(r.Body); string(b) != "upload" {
+			t.Errorf("Request body = %q, want %q", b, "upload")
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	asset, _, err := client.Repositories.UploadReleaseAsset(context.Background(), "o", "r", 1, &UploadOptions{Label: "l"}, file)
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAsset returned error: %v", err)
+	}
+
+	want := &ReleaseAsset{ID: Int(1)}
+	if !reflect.DeepEqual(asset, want) {
+		t.Errorf("Repositories.UploadReleaseAsset returned %+v, want %+v", asset, want)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAsset_offset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "go-github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "asset.bin")
+	if err := ioutil.WriteFile(name, []byte("headerupload"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.Seek(int64(len("header")), io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength != 6 {
+			t.Errorf("Content-Length = %v, want 6", r.ContentLength)
+		}
+		if b, _ := ioutil.ReadAll(r.Body); string(b) != "upload" {
+			t.Errorf("Request body = %q, want %q", b, "upload")
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, _, err = client.Repositories.UploadReleaseAsset(context.Background(), "o", "r", 1, nil, file)
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAsset returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"name": "n"})
+		testHeader(t, r, "Content-Type", "application/octet-stream")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, _, err := client.Repositories.UploadReleaseAssetFromReader(context.Background(), "o", "r", 1, &UploadOptions{Name: "n"}, strings.NewReader("data"), 4, "")
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_noName(t *testing.T) {
+	_, _, err := client.Repositories.UploadReleaseAssetFromReader(context.Background(), "o", "r", 1, nil, strings.NewReader("data"), 4, "")
+	if err == nil {
+		t.Error("Repositories.UploadReleaseAssetFromReader returned no error without a name")
+	}
+}
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -38,15 +39,20 @@ func (r RepoStatus) String() string {
 // reference.  ref can be a SHA, a branch name, or a tag name.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/statuses/#list-statuses-for-a-specific-ref
-func (s *RepositoriesService) ListStatuses(owner, repo, ref string) ([]RepoStatus, *Response, error) {
+func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref string, opt *ListOptions) ([]RepoStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/statuses/%v", owner, repo, ref)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	statuses := new([]RepoStatus)
-	resp, err := s.client.Do(req, statuses)
+	resp, err := s.client.Do(ctx, req, statuses)
 	return *statuses, resp, err
 }
 
@@ -54,7 +60,7 @@ func (s *RepositoriesService) ListStatuses(owner, repo, ref string) ([]RepoStatu
 // reference.  Ref can be a SHA, a branch name, or a tag name.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/statuses/#create-a-status
-func (s *RepositoriesService) CreateStatus(owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error) {
+func (s *RepositoriesService) CreateStatus(ctx context.Context, owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/statuses/%v", owner, repo, ref)
 	req, err := s.client.NewRequest("POST", u, status)
 	if err != nil {
@@ -62,6 +68,6 @@ func (s *RepositoriesService) CreateStatus(owner, repo, ref string, status *Repo
 	}
 
 	statuses := new(RepoStatus)
-	resp, err := s.client.Do(req, statuses)
+	resp, err := s.client.Do(ctx, req, statuses)
 	return statuses, resp, err
 }
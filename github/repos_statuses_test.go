@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestRepositoriesService_ListStatuses(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	statuses, _, err := client.Repositories.ListStatuses("o", "r", "r")
+	opt := &ListOptions{Page: 2}
+	statuses, _, err := client.Repositories.ListStatuses(context.Background(), "o", "r", "r", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListStatuses returned error: %v", err)
 	}
@@ -34,7 +37,7 @@ func TestRepositoriesService_ListStatuses(t *testing.T) {
 }
 
 func TestRepositoriesService_ListStatuses_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.ListStatuses("%", "r", "r")
+	_, _, err := client.Repositories.ListStatuses(context.Background(), "%", "r", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -55,7 +58,7 @@ func TestRepositoriesService_CreateStatus(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	status, _, err := client.Repositories.CreateStatus("o", "r", "r", input)
+	status, _, err := client.Repositories.CreateStatus(context.Background(), "o", "r", "r", input)
 	if err != nil {
 		t.Errorf("Repositories.CreateStatus returned error: %v", err)
 	}
@@ -67,6 +70,6 @@ func TestRepositoriesService_CreateStatus(t *testing.T) {
 }
 
 func TestRepositoriesService_CreateStatus_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.CreateStatus("%", "r", "r", nil)
+	_, _, err := client.Repositories.CreateStatus(context.Background(), "%", "r", "r", nil)
 	testURLParseError(t, err)
 }
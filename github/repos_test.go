@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +23,7 @@ func TestRepositoriesService_List_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
 	})
 
-	repos, _, err := client.Repositories.List("", nil)
+	repos, _, err := client.Repositories.List(context.Background(), "", nil)
 	if err != nil {
 		t.Errorf("Repositories.List returned error: %v", err)
 	}
@@ -49,8 +50,8 @@ func TestRepositoriesService_List_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	opt := &RepositoryListOptions{"owner", "created", "asc", 2}
-	repos, _, err := client.Repositories.List("u", opt)
+	opt := &RepositoryListOptions{"owner", "created", "asc", ListOptions{Page: 2}}
+	repos, _, err := client.Repositories.List(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Repositories.List returned error: %v", err)
 	}
@@ -62,7 +63,7 @@ func TestRepositoriesService_List_specifiedUser(t *testing.T) {
 }
 
 func TestRepositoriesService_List_invalidUser(t *testing.T) {
-	_, _, err := client.Repositories.List("%", nil)
+	_, _, err := client.Repositories.List(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -79,8 +80,8 @@ func TestRepositoriesService_ListByOrg(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	opt := &RepositoryListByOrgOptions{"forks", 2}
-	repos, _, err := client.Repositories.ListByOrg("o", opt)
+	opt := &RepositoryListByOrgOptions{"forks", ListOptions{Page: 2}}
+	repos, _, err := client.Repositories.ListByOrg(context.Background(), "o", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListByOrg returned error: %v", err)
 	}
@@ -92,7 +93,7 @@ func TestRepositoriesService_ListByOrg(t *testing.T) {
 }
 
 func TestRepositoriesService_ListByOrg_invalidOrg(t *testing.T) {
-	_, _, err := client.Repositories.ListByOrg("%", nil)
+	_, _, err := client.Repositories.ListByOrg(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -106,8 +107,8 @@ func TestRepositoriesService_ListAll(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	opt := &RepositoryListAllOptions{1}
-	repos, _, err := client.Repositories.ListAll(opt)
+	opt := &RepositoryListAllOptions{1, ListOptions{}}
+	repos, _, err := client.Repositories.ListAll(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Repositories.ListAll returned error: %v", err)
 	}
@@ -136,7 +137,7 @@ func TestRepositoriesService_Create_user(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	repo, _, err := client.Repositories.Create("", input)
+	repo, _, err := client.Repositories.Create(context.Background(), "", input)
 	if err != nil {
 		t.Errorf("Repositories.Create returned error: %v", err)
 	}
@@ -165,7 +166,7 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	repo, _, err := client.Repositories.Create("o", input)
+	repo, _, err := client.Repositories.Create(context.Background(), "o", input)
 	if err != nil {
 		t.Errorf("Repositories.Create returned error: %v", err)
 	}
@@ -177,7 +178,7 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 }
 
 func TestRepositoriesService_Create_invalidOrg(t *testing.T) {
-	_, _, err := client.Repositories.Create("%", nil)
+	_, _, err := client.Repositories.Create(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -190,7 +191,7 @@ func TestRepositoriesService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"id":1,"name":"n","description":"d","owner":{"login":"l"}}`)
 	})
 
-	repo, _, err := client.Repositories.Get("o", "r")
+	repo, _, err := client.Repositories.Get(context.Background(), "o", "r")
 	if err != nil {
 		t.Errorf("Repositories.Get returned error: %v", err)
 	}
@@ -219,7 +220,7 @@ func TestRepositoriesService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	repo, _, err := client.Repositories.Edit("o", "r", input)
+	repo, _, err := client.Repositories.Edit(context.Background(), "o", "r", input)
 	if err != nil {
 		t.Errorf("Repositories.Edit returned error: %v", err)
 	}
@@ -231,12 +232,12 @@ func TestRepositoriesService_Edit(t *testing.T) {
 }
 
 func TestRepositoriesService_Get_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.Get("%", "r")
+	_, _, err := client.Repositories.Get(context.Background(), "%", "r")
 	testURLParseError(t, err)
 }
 
 func TestRepositoriesService_Edit_invalidOwner(t *testing.T) {
-	_, _, err := client.Repositories.Edit("%", "r", nil)
+	_, _, err := client.Repositories.Edit(context.Background(), "%", "r", nil)
 	testURLParseError(t, err)
 }
 
@@ -249,7 +250,7 @@ func TestRepositoriesService_ListLanguages(t *testing.T) {
 		fmt.Fprint(w, `{"go":1}`)
 	})
 
-	languages, _, err := client.Repositories.ListLanguages("u", "r")
+	languages, _, err := client.Repositories.ListLanguages(context.Background(), "u", "r")
 	if err != nil {
 		t.Errorf("Repositories.ListLanguages returned error: %v", err)
 	}
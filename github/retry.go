@@ -0,0 +1,181 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = 1 * time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// defaultRetryMethods are the HTTP methods retried when RetryPolicy.Methods
+// is nil.  They are idempotent, so sending them again is safe even if an
+// earlier attempt reached GitHub.
+var defaultRetryMethods = []string{"GET", "HEAD", "PUT", "DELETE"}
+
+// RetryPolicy specifies how a Client retries requests that failed with a
+// transient error: a transport error such as a connection reset, a 502, 503
+// or 504 response, or a 403 or 429 response carrying a Retry-After header.
+//
+// Retries are delayed by an exponential backoff with jitter, starting at
+// MinBackoff and doubling up to MaxBackoff.  A Retry-After header sent by
+// GitHub takes precedence over the computed delay.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt.  Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry.  Defaults to 1 second.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts.  Defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// Methods lists the HTTP methods that are retried.  If nil, only GET,
+	// HEAD, PUT and DELETE requests are retried.
+	Methods []string
+
+	// OnAttempt, if non-nil, is called after every attempt, including the
+	// last one.
+	OnAttempt func(RetryAttempt)
+}
+
+// RetryAttempt describes a single attempt at sending a request, as reported
+// to RetryPolicy.OnAttempt.
+type RetryAttempt struct {
+	// Request is the request that was sent.
+	Request *http.Request
+
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+
+	// Response is the response received, or nil if Err is set.
+	Response *http.Response
+
+	// Err is the transport error, if any.  Error responses from GitHub are
+	// reported through Response instead.
+	Err error
+
+	// Retry reports whether the request will be sent again, after waiting
+	// for Wait.
+	Retry bool
+	Wait  time.Duration
+}
+
+// retriesMethod reports whether requests with the given method are retried.
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	methods := p.Methods
+	if methods == nil {
+		methods = defaultRetryMethods
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldRetry reports whether the outcome of an attempt is a transient
+// failure, and the delay GitHub asked for before retrying, if any.
+func shouldRetry(resp *http.Response, err error) (bool, *time.Duration) {
+	if err != nil {
+		return true, nil
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true, parseRetryAfter(resp.Header)
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		if d := parseRetryAfter(resp.Header); d != nil {
+			return true, d
+		}
+	}
+	return false, nil
+}
+
+// backoff returns the delay before the given retry, counting from 1.  Half of
+// the delay is fixed and the other half random, so that clients failing at
+// the same time do not retry in lockstep.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = defaultRetryMinBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	d := min
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// send sends req through c.Middleware, retrying it according to c.Retry.  The
+// body of req is rewound with req.GetBody before each retry.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rt := c.roundTripper()
+	p := c.Retry
+	if p == nil || p.MaxAttempts < 2 || !p.retriesMethod(req.Method) {
+		return rt(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := rt(req)
+
+		retry, wait := shouldRetry(resp, err)
+		if ctx.Err() != nil || attempt >= p.MaxAttempts || (req.Body != nil && req.GetBody == nil) {
+			retry = false
+		}
+		a := RetryAttempt{Request: req, Attempt: attempt, Response: resp, Err: err, Retry: retry}
+		if retry {
+			if wait != nil {
+				a.Wait = *wait
+			} else {
+				a.Wait = p.backoff(attempt)
+			}
+		}
+		if p.OnAttempt != nil {
+			p.OnAttempt(a)
+		}
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		t := time.NewTimer(a.Wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewRequest_replayableBody(t *testing.T) {
+	c := NewClient(nil)
+	req, _ := c.NewRequest("PUT", "/", &User{Login: String("l")})
+
+	if req.GetBody == nil {
+		t.Fatalf("NewRequest did not set GetBody")
+	}
+	ioutil.ReadAll(req.Body)
+
+	body, err := req.GetBody()
+	if err != nil {
+		t.Fatalf("GetBody returned error: %v", err)
+	}
+	got, _ := ioutil.ReadAll(body)
+	if want := `{"login":"l"}` + "\n"; string(got) != want {
+		t.Errorf("Replayed body = %v, want %v", string(got), want)
+	}
+}
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	var attempts []RetryAttempt
+	client.Retry = &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		OnAttempt:   func(a RetryAttempt) { attempts = append(attempts, a) },
+	}
+
+	req, _ := client.NewRequest("PUT", "/", &User{Login: String("l")})
+	body := new(struct{ A string })
+	if _, err := client.Do(context.Background(), req, body); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if body.A != "a" {
+		t.Errorf("Response body = %+v, want A: a", body)
+	}
+
+	want := []string{`{"login":"l"}` + "\n", `{"login":"l"}` + "\n", `{"login":"l"}` + "\n"}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Errorf("Request bodies = %q, want %q", bodies, want)
+	}
+
+	if len(attempts) != 3 {
+		t.Fatalf("OnAttempt called %d times, want 3", len(attempts))
+	}
+	for i, a := range attempts {
+		if a.Attempt != i+1 {
+			t.Errorf("attempts[%d].Attempt = %d, want %d", i, a.Attempt, i+1)
+		}
+		if want := i < 2; a.Retry != want {
+			t.Errorf("attempts[%d].Retry = %v, want %v", i, a.Retry, want)
+		}
+	}
+	if got := attempts[0].Response.StatusCode; got != http.StatusServiceUnavailable {
+		t.Errorf("attempts[0].Response.StatusCode = %d, want %d", got, http.StatusServiceUnavailable)
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	})
+
+	client.Retry = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(context.Background(), req, nil)
+
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("Do returned error %v, want a *ServerError", err)
+	}
+	if requests != 2 {
+		t.Errorf("Server received %d requests, want 2", requests)
+	}
+}
+
+func TestDo_retrySkipsPOST(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+	req, _ := client.NewRequest("POST", "/", nil)
+	client.Do(context.Background(), req, nil)
+
+	if requests != 1 {
+		t.Errorf("Server received %d requests, want 1", requests)
+	}
+}
+
+func TestDo_retryDoesNotRetryClientErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+	req, _ := client.NewRequest("GET", "/", nil)
+	client.Do(context.Background(), req, nil)
+
+	if requests != 1 {
+		t.Errorf("Server received %d requests, want 1", requests)
+	}
+}
+
+func TestDo_retryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "abuse", http.StatusForbidden)
+		}
+	})
+
+	var waits []time.Duration
+	client.Retry = &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Hour,
+		OnAttempt: func(a RetryAttempt) {
+			if a.Retry {
+				waits = append(waits, a.Wait)
+			}
+		},
+	}
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+
+	if want := []time.Duration{0}; !reflect.DeepEqual(waits, want) {
+		t.Errorf("Retry waits = %v, want %v", waits, want)
+	}
+}
+
+func TestDo_retryCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	tests := []struct {
+		retry    int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if d := p.backoff(tt.retry); d < tt.min || d > tt.max {
+				t.Errorf("backoff(%d) = %v, want between %v and %v", tt.retry, d, tt.min, tt.max)
+			}
+		}
+	}
+}
@@ -6,9 +6,9 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 )
 
 // SearchService provides access to the search related functions
@@ -28,18 +28,13 @@ type SearchOptions struct {
 	//   - for users: followers, repositories, joined
 	//
 	// Default is to sort by best match.
-	Sort string
+	Sort string `url:"sort,omitempty"`
 
 	// Sort order if sort parameter is provided. Possible values are: asc,
 	// desc. Default is desc.
-	Order string
+	Order string `url:"order,omitempty"`
 
-	// Page of results to retrieve.
-	Page int
-
-	// Number of results to show per page.  This can be up to 100
-	// according to GitHub.
-	PerPage int
+	ListOptions
 }
 
 // RepositoriesSearchResult represents the result of a repositories search.
@@ -51,9 +46,9 @@ type RepositoriesSearchResult struct {
 // Repositories searches repositories via various criteria.
 //
 // GitHub API docs: http://developer.github.com/v3/search/#search-repositories
-func (s *SearchService) Repositories(query string, opt *SearchOptions) (*RepositoriesSearchResult, *Response, error) {
+func (s *SearchService) Repositories(ctx context.Context, query string, opt *SearchOptions) (*RepositoriesSearchResult, *Response, error) {
 	result := new(RepositoriesSearchResult)
-	resp, err := s.search("repositories", query, opt, result)
+	resp, err := s.search(ctx, "repositories", query, opt, result)
 	return result, resp, err
 }
 
@@ -66,9 +61,9 @@ type IssuesSearchResult struct {
 // Issues searches issues via various criteria.
 //
 // GitHub API docs: http://developer.github.com/v3/search/#search-issues
-func (s *SearchService) Issues(query string, opt *SearchOptions) (*IssuesSearchResult, *Response, error) {
+func (s *SearchService) Issues(ctx context.Context, query string, opt *SearchOptions) (*IssuesSearchResult, *Response, error) {
 	result := new(IssuesSearchResult)
-	resp, err := s.search("issues", query, opt, result)
+	resp, err := s.search(ctx, "issues", query, opt, result)
 	return result, resp, err
 }
 
@@ -81,9 +76,9 @@ type UsersSearchResult struct {
 // Users searches users via various criteria.
 //
 // GitHub API docs: http://developer.github.com/v3/search/#search-users
-func (s *SearchService) Users(query string, opt *SearchOptions) (*UsersSearchResult, *Response, error) {
+func (s *SearchService) Users(ctx context.Context, query string, opt *SearchOptions) (*UsersSearchResult, *Response, error) {
 	result := new(UsersSearchResult)
-	resp, err := s.search("users", query, opt, result)
+	resp, err := s.search(ctx, "users", query, opt, result)
 	return result, resp, err
 }
 
@@ -109,23 +104,21 @@ func (c CodeResult) String() string {
 // Code searches code via various criteria.
 //
 // GitHub API docs: http://developer.github.com/v3/search/#search-code
-func (s *SearchService) Code(query string, opt *SearchOptions) (*CodeSearchResult, *Response, error) {
+func (s *SearchService) Code(ctx context.Context, query string, opt *SearchOptions) (*CodeSearchResult, *Response, error) {
 	result := new(CodeSearchResult)
-	resp, err := s.search("code", query, opt, result)
+	resp, err := s.search(ctx, "code", query, opt, result)
 	return result, resp, err
 }
 
 // Helper function that executes search queries against different
 // GitHub search types (repositories, code, issues, users)
-func (s *SearchService) search(searchType string, query string, opt *SearchOptions, result interface{}) (*Response, error) {
+func (s *SearchService) search(ctx context.Context, searchType string, query string, opt *SearchOptions, result interface{}) (*Response, error) {
 	params := url.Values{"q": []string{query}}
-	if opt != nil {
-		params.Add("sort", opt.Sort)
-		params.Add("order", opt.Order)
-		params.Add("page", strconv.Itoa(opt.Page))
-		params.Add("per_page", strconv.Itoa(opt.PerPage))
-	}
 	u := fmt.Sprintf("search/%s?%s", searchType, params.Encode())
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -133,6 +126,6 @@ func (s *SearchService) search(searchType string, query string, opt *SearchOptio
 	}
 	req.Header.Add("Accept", mimePreview)
 
-	resp, err := s.client.Do(req, result)
+	resp, err := s.client.Do(ctx, req, result)
 	return resp, err
 }
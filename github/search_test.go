@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -26,8 +27,8 @@ func TestSearchService_Repositories(t *testing.T) {
 		fmt.Fprint(w, `{"total_count": 4, "items": [{"id":1},{"id":2}]}`)
 	})
 
-	opts := &SearchOptions{Sort: "forks", Order: "desc", Page: 2, PerPage: 2}
-	result, _, err := client.Search.Repositories("blah", opts)
+	opts := &SearchOptions{Sort: "forks", Order: "desc", ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	result, _, err := client.Search.Repositories(context.Background(), "blah", opts)
 	if err != nil {
 		t.Errorf("Search.Repositories returned error: %v", err)
 	}
@@ -59,8 +60,8 @@ func TestSearchService_Issues(t *testing.T) {
 		fmt.Fprint(w, `{"total_count": 4, "items": [{"number":1},{"number":2}]}`)
 	})
 
-	opts := &SearchOptions{Sort: "forks", Order: "desc", Page: 2, PerPage: 2}
-	result, _, err := client.Search.Issues("blah", opts)
+	opts := &SearchOptions{Sort: "forks", Order: "desc", ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	result, _, err := client.Search.Issues(context.Background(), "blah", opts)
 	if err != nil {
 		t.Errorf("Search.Issues returned error: %v", err)
 	}
@@ -92,8 +93,8 @@ func TestSearchService_Users(t *testing.T) {
 		fmt.Fprint(w, `{"total_count": 4, "items": [{"id":1},{"id":2}]}`)
 	})
 
-	opts := &SearchOptions{Sort: "forks", Order: "desc", Page: 2, PerPage: 2}
-	result, _, err := client.Search.Users("blah", opts)
+	opts := &SearchOptions{Sort: "forks", Order: "desc", ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	result, _, err := client.Search.Users(context.Background(), "blah", opts)
 	if err != nil {
 		t.Errorf("Search.Issues returned error: %v", err)
 	}
@@ -125,8 +126,8 @@ func TestSearchService_Code(t *testing.T) {
 		fmt.Fprint(w, `{"total_count": 4, "items": [{"name":"1"},{"name":"2"}]}`)
 	})
 
-	opts := &SearchOptions{Sort: "forks", Order: "desc", Page: 2, PerPage: 2}
-	result, _, err := client.Search.Code("blah", opts)
+	opts := &SearchOptions{Sort: "forks", Order: "desc", ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	result, _, err := client.Search.Code(context.Background(), "blah", opts)
 	if err != nil {
 		t.Errorf("Search.Code returned error: %v", err)
 	}
@@ -6,9 +6,8 @@
 package github
 
 import (
+	"context"
 	"fmt"
-	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -47,7 +46,7 @@ func (u User) String() string {
 // user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/#get-a-single-user
-func (s *UsersService) Get(user string) (*User, *Response, error) {
+func (s *UsersService) Get(ctx context.Context, user string) (*User, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v", user)
@@ -60,14 +59,14 @@ func (s *UsersService) Get(user string) (*User, *Response, error) {
 	}
 
 	uResp := new(User)
-	resp, err := s.client.Do(req, uResp)
+	resp, err := s.client.Do(ctx, req, uResp)
 	return uResp, resp, err
 }
 
 // Edit the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/#update-the-authenticated-user
-func (s *UsersService) Edit(user *User) (*User, *Response, error) {
+func (s *UsersService) Edit(ctx context.Context, user *User) (*User, *Response, error) {
 	u := "user"
 	req, err := s.client.NewRequest("PATCH", u, user)
 	if err != nil {
@@ -75,7 +74,7 @@ func (s *UsersService) Edit(user *User) (*User, *Response, error) {
 	}
 
 	uResp := new(User)
-	resp, err := s.client.Do(req, uResp)
+	resp, err := s.client.Do(ctx, req, uResp)
 	return uResp, resp, err
 }
 
@@ -83,19 +82,19 @@ func (s *UsersService) Edit(user *User) (*User, *Response, error) {
 // method.
 type UserListOptions struct {
 	// ID of the last user seen
-	Since int
+	Since int `url:"since,omitempty"`
+
+	ListOptions
 }
 
 // ListAll lists all GitHub users.
 //
 // GitHub API docs: http://developer.github.com/v3/users/#get-all-users
-func (s *UsersService) ListAll(opt *UserListOptions) ([]User, *Response, error) {
+func (s *UsersService) ListAll(ctx context.Context, opt *UserListOptions) ([]User, *Response, error) {
 	u := "users"
-	if opt != nil {
-		params := url.Values{
-			"since": []string{strconv.Itoa(opt.Since)},
-		}
-		u += "?" + params.Encode()
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -104,6 +103,6 @@ func (s *UsersService) ListAll(opt *UserListOptions) ([]User, *Response, error)
 	}
 
 	users := new([]User)
-	resp, err := s.client.Do(req, users)
+	resp, err := s.client.Do(ctx, req, users)
 	return *users, resp, err
 }
@@ -5,28 +5,35 @@
 
 package github
 
+import "context"
+
 // UserEmail represents user's email address
 type UserEmail string
 
 // ListEmails lists all authenticated user email addresses
 //
 // GitHub API docs: http://developer.github.com/v3/users/emails/#list-email-addresses-for-a-user
-func (s *UsersService) ListEmails() ([]UserEmail, *Response, error) {
+func (s *UsersService) ListEmails(ctx context.Context, opt *ListOptions) ([]UserEmail, *Response, error) {
 	u := "user/emails"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	emails := new([]UserEmail)
-	resp, err := s.client.Do(req, emails)
+	resp, err := s.client.Do(ctx, req, emails)
 	return *emails, resp, err
 }
 
 // AddEmails adds email addresses of authenticated user
 //
 // GitHub API docs: http://developer.github.com/v3/users/emails/#add-email-addresses
-func (s *UsersService) AddEmails(emails []UserEmail) ([]UserEmail, *Response, error) {
+func (s *UsersService) AddEmails(ctx context.Context, emails []UserEmail) ([]UserEmail, *Response, error) {
 	u := "user/emails"
 	req, err := s.client.NewRequest("POST", u, emails)
 	if err != nil {
@@ -34,19 +41,19 @@ func (s *UsersService) AddEmails(emails []UserEmail) ([]UserEmail, *Response, er
 	}
 
 	e := new([]UserEmail)
-	resp, err := s.client.Do(req, e)
+	resp, err := s.client.Do(ctx, req, e)
 	return *e, resp, err
 }
 
 // DeleteEmails deletes email addresses from authenticated user
 //
 // GitHub API docs: http://developer.github.com/v3/users/emails/#delete-email-addresses
-func (s *UsersService) DeleteEmails(emails []UserEmail) (*Response, error) {
+func (s *UsersService) DeleteEmails(ctx context.Context, emails []UserEmail) (*Response, error) {
 	u := "user/emails"
 	req, err := s.client.NewRequest("DELETE", u, emails)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestUsersService_ListEmails(t *testing.T) {
 
 	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `["user@example.com"]`)
 	})
 
-	emails, _, err := client.Users.ListEmails()
+	opt := &ListOptions{Page: 2}
+	emails, _, err := client.Users.ListEmails(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Users.ListEmails returned error: %v", err)
 	}
@@ -51,7 +54,7 @@ func TestUsersService_AddEmails(t *testing.T) {
 		fmt.Fprint(w, `["old@example.com", "new@example.com"]`)
 	})
 
-	emails, _, err := client.Users.AddEmails(input)
+	emails, _, err := client.Users.AddEmails(context.Background(), input)
 	if err != nil {
 		t.Errorf("Users.AddEmails returned error: %v", err)
 	}
@@ -78,7 +81,7 @@ func TestUsersService_DeleteEmails(t *testing.T) {
 		}
 	})
 
-	_, err := client.Users.DeleteEmails(input)
+	_, err := client.Users.DeleteEmails(context.Background(), input)
 	if err != nil {
 		t.Errorf("Users.DeleteEmails returned error: %v", err)
 	}
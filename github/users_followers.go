@@ -5,13 +5,16 @@
 
 package github
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ListFollowers lists the followers for a user.  Passing the empty string will
 // fetch followers for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/followers/#list-followers-of-a-user
-func (s *UsersService) ListFollowers(user string) ([]User, *Response, error) {
+func (s *UsersService) ListFollowers(ctx context.Context, user string, opt *ListOptions) ([]User, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/followers", user)
@@ -19,13 +22,18 @@ func (s *UsersService) ListFollowers(user string) ([]User, *Response, error) {
 		u = "user/followers"
 	}
 
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	users := new([]User)
-	resp, err := s.client.Do(req, users)
+	resp, err := s.client.Do(ctx, req, users)
 	return *users, resp, err
 }
 
@@ -33,7 +41,7 @@ func (s *UsersService) ListFollowers(user string) ([]User, *Response, error) {
 // string will list people the authenticated user is following.
 //
 // GitHub API docs: http://developer.github.com/v3/users/followers/#list-users-followed-by-another-user
-func (s *UsersService) ListFollowing(user string) ([]User, *Response, error) {
+func (s *UsersService) ListFollowing(ctx context.Context, user string, opt *ListOptions) ([]User, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/following", user)
@@ -41,13 +49,18 @@ func (s *UsersService) ListFollowing(user string) ([]User, *Response, error) {
 		u = "user/following"
 	}
 
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	users := new([]User)
-	resp, err := s.client.Do(req, users)
+	resp, err := s.client.Do(ctx, req, users)
 	return *users, resp, err
 }
 
@@ -55,7 +68,7 @@ func (s *UsersService) ListFollowing(user string) ([]User, *Response, error) {
 // string for "user" will check if the authenticated user is following "target".
 //
 // GitHub API docs: http://developer.github.com/v3/users/followers/#check-if-you-are-following-a-user
-func (s *UsersService) IsFollowing(user, target string) (bool, *Response, error) {
+func (s *UsersService) IsFollowing(ctx context.Context, user, target string) (bool, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/following/%v", user, target)
@@ -68,7 +81,7 @@ func (s *UsersService) IsFollowing(user, target string) (bool, *Response, error)
 		return false, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
 	following, err := parseBoolResponse(err)
 	return following, resp, err
 }
@@ -76,25 +89,25 @@ func (s *UsersService) IsFollowing(user, target string) (bool, *Response, error)
 // Follow will cause the authenticated user to follow the specified user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/followers/#follow-a-user
-func (s *UsersService) Follow(user string) (*Response, error) {
+func (s *UsersService) Follow(ctx context.Context, user string) (*Response, error) {
 	u := fmt.Sprintf("user/following/%v", user)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // Unfollow will cause the authenticated user to unfollow the specified user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/followers/#unfollow-a-user
-func (s *UsersService) Unfollow(user string) (*Response, error) {
+func (s *UsersService) Unfollow(ctx context.Context, user string) (*Response, error) {
 	u := fmt.Sprintf("user/following/%v", user)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -18,10 +19,12 @@ func TestUsersService_ListFollowers_authenticatedUser(t *testing.T) {
 
 	mux.HandleFunc("/user/followers", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	users, _, err := client.Users.ListFollowers("")
+	opt := &ListOptions{Page: 2}
+	users, _, err := client.Users.ListFollowers(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListFollowers returned error: %v", err)
 	}
@@ -38,10 +41,12 @@ func TestUsersService_ListFollowers_specifiedUser(t *testing.T) {
 
 	mux.HandleFunc("/users/u/followers", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	users, _, err := client.Users.ListFollowers("u")
+	opt := &ListOptions{Page: 2}
+	users, _, err := client.Users.ListFollowers(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Users.ListFollowers returned error: %v", err)
 	}
@@ -53,7 +58,7 @@ func TestUsersService_ListFollowers_specifiedUser(t *testing.T) {
 }
 
 func TestUsersService_ListFollowers_invalidUser(t *testing.T) {
-	_, _, err := client.Users.ListFollowers("%")
+	_, _, err := client.Users.ListFollowers(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -63,10 +68,12 @@ func TestUsersService_ListFollowing_authenticatedUser(t *testing.T) {
 
 	mux.HandleFunc("/user/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	users, _, err := client.Users.ListFollowing("")
+	opt := &ListOptions{Page: 2}
+	users, _, err := client.Users.ListFollowing(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListFollowing returned error: %v", err)
 	}
@@ -83,10 +90,12 @@ func TestUsersService_ListFollowing_specifiedUser(t *testing.T) {
 
 	mux.HandleFunc("/users/u/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	users, _, err := client.Users.ListFollowing("u")
+	opt := &ListOptions{Page: 2}
+	users, _, err := client.Users.ListFollowing(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Users.ListFollowing returned error: %v", err)
 	}
@@ -98,7 +107,7 @@ func TestUsersService_ListFollowing_specifiedUser(t *testing.T) {
 }
 
 func TestUsersService_ListFollowing_invalidUser(t *testing.T) {
-	_, _, err := client.Users.ListFollowing("%")
+	_, _, err := client.Users.ListFollowing(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -111,7 +120,7 @@ func TestUsersService_IsFollowing_authenticatedUser(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	following, _, err := client.Users.IsFollowing("", "t")
+	following, _, err := client.Users.IsFollowing(context.Background(), "", "t")
 	if err != nil {
 		t.Errorf("Users.IsFollowing returned error: %v", err)
 	}
@@ -129,7 +138,7 @@ func TestUsersService_IsFollowing_specifiedUser(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	following, _, err := client.Users.IsFollowing("u", "t")
+	following, _, err := client.Users.IsFollowing(context.Background(), "u", "t")
 	if err != nil {
 		t.Errorf("Users.IsFollowing returned error: %v", err)
 	}
@@ -147,7 +156,7 @@ func TestUsersService_IsFollowing_false(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	following, _, err := client.Users.IsFollowing("u", "t")
+	following, _, err := client.Users.IsFollowing(context.Background(), "u", "t")
 	if err != nil {
 		t.Errorf("Users.IsFollowing returned error: %v", err)
 	}
@@ -165,7 +174,7 @@ func TestUsersService_IsFollowing_error(t *testing.T) {
 		http.Error(w, "BadRequest", http.StatusBadRequest)
 	})
 
-	following, _, err := client.Users.IsFollowing("u", "t")
+	following, _, err := client.Users.IsFollowing(context.Background(), "u", "t")
 	if err == nil {
 		t.Errorf("Expected HTTP 400 response")
 	}
@@ -175,7 +184,7 @@ func TestUsersService_IsFollowing_error(t *testing.T) {
 }
 
 func TestUsersService_IsFollowing_invalidUser(t *testing.T) {
-	_, _, err := client.Users.IsFollowing("%", "%")
+	_, _, err := client.Users.IsFollowing(context.Background(), "%", "%")
 	testURLParseError(t, err)
 }
 
@@ -187,14 +196,14 @@ func TestUsersService_Follow(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.Follow("u")
+	_, err := client.Users.Follow(context.Background(), "u")
 	if err != nil {
 		t.Errorf("Users.Follow returned error: %v", err)
 	}
 }
 
 func TestUsersService_Follow_invalidUser(t *testing.T) {
-	_, err := client.Users.Follow("%")
+	_, err := client.Users.Follow(context.Background(), "%")
 	testURLParseError(t, err)
 }
 
@@ -206,13 +215,13 @@ func TestUsersService_Unfollow(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.Unfollow("u")
+	_, err := client.Users.Unfollow(context.Background(), "u")
 	if err != nil {
 		t.Errorf("Users.Follow returned error: %v", err)
 	}
 }
 
 func TestUsersService_Unfollow_invalidUser(t *testing.T) {
-	_, err := client.Users.Unfollow("%")
+	_, err := client.Users.Unfollow(context.Background(), "%")
 	testURLParseError(t, err)
 }
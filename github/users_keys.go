@@ -5,7 +5,10 @@
 
 package github
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Key represents a public SSH key used to authenticate a user or deploy script.
 type Key struct {
@@ -23,7 +26,7 @@ func (k Key) String() string {
 // string will fetch keys for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/keys/#list-public-keys-for-a-user
-func (s *UsersService) ListKeys(user string) ([]Key, *Response, error) {
+func (s *UsersService) ListKeys(ctx context.Context, user string, opt *ListOptions) ([]Key, *Response, error) {
 	var u string
 	if user != "" {
 		u = fmt.Sprintf("users/%v/keys", user)
@@ -31,20 +34,25 @@ func (s *UsersService) ListKeys(user string) ([]Key, *Response, error) {
 		u = "user/keys"
 	}
 
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	keys := new([]Key)
-	resp, err := s.client.Do(req, keys)
+	resp, err := s.client.Do(ctx, req, keys)
 	return *keys, resp, err
 }
 
 // GetKey fetches a single public key.
 //
 // GitHub API docs: http://developer.github.com/v3/users/keys/#get-a-single-public-key
-func (s *UsersService) GetKey(id int) (*Key, *Response, error) {
+func (s *UsersService) GetKey(ctx context.Context, id int) (*Key, *Response, error) {
 	u := fmt.Sprintf("user/keys/%v", id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -53,14 +61,14 @@ func (s *UsersService) GetKey(id int) (*Key, *Response, error) {
 	}
 
 	key := new(Key)
-	resp, err := s.client.Do(req, key)
+	resp, err := s.client.Do(ctx, req, key)
 	return key, resp, err
 }
 
 // CreateKey adds a public key for the authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/users/keys/#create-a-public-key
-func (s *UsersService) CreateKey(key *Key) (*Key, *Response, error) {
+func (s *UsersService) CreateKey(ctx context.Context, key *Key) (*Key, *Response, error) {
 	u := "user/keys"
 
 	req, err := s.client.NewRequest("POST", u, key)
@@ -69,14 +77,14 @@ func (s *UsersService) CreateKey(key *Key) (*Key, *Response, error) {
 	}
 
 	k := new(Key)
-	resp, err := s.client.Do(req, k)
+	resp, err := s.client.Do(ctx, req, k)
 	return k, resp, err
 }
 
 // EditKey edits a public key.
 //
 // GitHub API docs: http://developer.github.com/v3/users/keys/#update-a-public-key
-func (s *UsersService) EditKey(id int, key *Key) (*Key, *Response, error) {
+func (s *UsersService) EditKey(ctx context.Context, id int, key *Key) (*Key, *Response, error) {
 	u := fmt.Sprintf("user/keys/%v", id)
 
 	req, err := s.client.NewRequest("PATCH", u, key)
@@ -85,14 +93,14 @@ func (s *UsersService) EditKey(id int, key *Key) (*Key, *Response, error) {
 	}
 
 	k := new(Key)
-	resp, err := s.client.Do(req, k)
+	resp, err := s.client.Do(ctx, req, k)
 	return k, resp, err
 }
 
 // DeleteKey deletes a public key.
 //
 // GitHub API docs: http://developer.github.com/v3/users/keys/#delete-a-public-key
-func (s *UsersService) DeleteKey(id int) (*Response, error) {
+func (s *UsersService) DeleteKey(ctx context.Context, id int) (*Response, error) {
 	u := fmt.Sprintf("user/keys/%v", id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -100,5 +108,5 @@ func (s *UsersService) DeleteKey(id int) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,10 +20,12 @@ func TestUsersService_ListKeys_authenticatedUser(t *testing.T) {
 
 	mux.HandleFunc("/user/keys", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	keys, _, err := client.Users.ListKeys("")
+	opt := &ListOptions{Page: 2}
+	keys, _, err := client.Users.ListKeys(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListKeys returned error: %v", err)
 	}
@@ -39,10 +42,12 @@ func TestUsersService_ListKeys_specifiedUser(t *testing.T) {
 
 	mux.HandleFunc("/users/u/keys", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	keys, _, err := client.Users.ListKeys("u")
+	opt := &ListOptions{Page: 2}
+	keys, _, err := client.Users.ListKeys(context.Background(), "u", opt)
 	if err != nil {
 		t.Errorf("Users.ListKeys returned error: %v", err)
 	}
@@ -54,7 +59,7 @@ func TestUsersService_ListKeys_specifiedUser(t *testing.T) {
 }
 
 func TestUsersService_ListKeys_invalidUser(t *testing.T) {
-	_, _, err := client.Users.ListKeys("%")
+	_, _, err := client.Users.ListKeys(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
 
@@ -67,7 +72,7 @@ func TestUsersService_GetKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Users.GetKey(1)
+	key, _, err := client.Users.GetKey(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Users.GetKey returned error: %v", err)
 	}
@@ -96,7 +101,7 @@ func TestUsersService_CreateKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Users.CreateKey(input)
+	key, _, err := client.Users.CreateKey(context.Background(), input)
 	if err != nil {
 		t.Errorf("Users.GetKey returned error: %v", err)
 	}
@@ -125,7 +130,7 @@ func TestUsersService_EditKey(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	key, _, err := client.Users.EditKey(1, input)
+	key, _, err := client.Users.EditKey(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Users.EditKey returned error: %v", err)
 	}
@@ -144,7 +149,7 @@ func TestUsersService_DeleteKey(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.DeleteKey(1)
+	_, err := client.Users.DeleteKey(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Users.DeleteKey returned error: %v", err)
 	}
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +23,7 @@ func TestUsersService_Get_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	user, _, err := client.Users.Get("")
+	user, _, err := client.Users.Get(context.Background(), "")
 	if err != nil {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
@@ -42,7 +43,7 @@ func TestUsersService_Get_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	user, _, err := client.Users.Get("u")
+	user, _, err := client.Users.Get(context.Background(), "u")
 	if err != nil {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
@@ -54,7 +55,7 @@ func TestUsersService_Get_specifiedUser(t *testing.T) {
 }
 
 func TestUsersService_Get_invalidUser(t *testing.T) {
-	_, _, err := client.Users.Get("%")
+	_, _, err := client.Users.Get(context.Background(), "%")
 	testURLParseError(t, err)
 }
 
@@ -76,7 +77,7 @@ func TestUsersService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
-	user, _, err := client.Users.Edit(input)
+	user, _, err := client.Users.Edit(context.Background(), input)
 	if err != nil {
 		t.Errorf("Users.Edit returned error: %v", err)
 	}
@@ -97,8 +98,8 @@ func TestUsersService_ListAll(t *testing.T) {
 		fmt.Fprint(w, `[{"id":2}]`)
 	})
 
-	opt := &UserListOptions{1}
-	users, _, err := client.Users.ListAll(opt)
+	opt := &UserListOptions{1, ListOptions{}}
+	users, _, err := client.Users.ListAll(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
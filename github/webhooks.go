@@ -0,0 +1,361 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	headerEvent        = "X-GitHub-Event"
+	headerDelivery     = "X-GitHub-Delivery"
+	headerSignature    = "X-Hub-Signature"
+	headerSignature256 = "X-Hub-Signature-256"
+
+	// maxWebHookPayload is the size above which GitHub truncates payloads.
+	maxWebHookPayload = 25 << 20
+
+	// defaultRememberedDeliveries is the default number of delivery IDs a
+	// WebHookHandler remembers to reject replays.
+	defaultRememberedDeliveries = 10000
+)
+
+// Errors returned when validating webhook deliveries.
+var (
+	ErrInvalidSignature = errors.New("github: invalid webhook signature")
+	ErrReplayedDelivery = errors.New("github: webhook delivery already received")
+)
+
+// ValidateSignature checks that signature, the value of the X-Hub-Signature
+// or X-Hub-Signature-256 header of a webhook delivery, is the HMAC of body
+// keyed with the secret configured for the hook.  The comparison takes
+// constant time.  It returns ErrInvalidSignature if the signature is missing,
+// malformed or wrong.
+//
+// GitHub API docs: http://developer.github.com/v3/repos/hooks/#example
+func ValidateSignature(signature string, body, secret []byte) error {
+	parts := strings.SplitN(signature, "=", 2)
+	if len(parts) != 2 {
+		return ErrInvalidSignature
+	}
+	var h func() hash.Hash
+	switch parts[0] {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	default:
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(h, secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ParseWebHook decodes the payload of a webhook delivery of the given event
+// type, the value of its X-GitHub-Event header, and returns a pointer to the
+// corresponding event struct: *WebHookPayload for "push", *PullRequestEvent
+// for "pull_request", and so on.  It returns an error for event types it does
+// not know.
+func ParseWebHook(eventType string, payload []byte) (interface{}, error) {
+	var event interface{}
+	switch eventType {
+	case "commit_comment":
+		event = new(CommitCommentEvent)
+	case "create":
+		event = new(CreateEvent)
+	case "delete":
+		event = new(DeleteEvent)
+	case "fork":
+		event = new(ForkEvent)
+	case "gollum":
+		event = new(GollumEvent)
+	case "issue_comment":
+		event = new(IssueCommentEvent)
+	case "issues":
+		event = new(IssuesEvent)
+	case "member":
+		event = new(MemberEvent)
+	case "ping":
+		event = new(PingEvent)
+	case "public":
+		event = new(PublicEvent)
+	case "pull_request":
+		event = new(PullRequestEvent)
+	case "pull_request_review_comment":
+		event = new(PullRequestReviewCommentEvent)
+	case "push":
+		event = new(WebHookPayload)
+	case "release":
+		event = new(ReleaseEvent)
+	case "status":
+		event = new(StatusEvent)
+	case "team_add":
+		event = new(TeamAddEvent)
+	case "watch":
+		event = new(WatchEvent)
+	default:
+		return nil, fmt.Errorf("github: unknown webhook event type %q", eventType)
+	}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// WebHookDelivery describes a webhook delivery received by a WebHookHandler.
+type WebHookDelivery struct {
+	// ID is the unique ID of the delivery, from the X-GitHub-Delivery header.
+	ID string
+
+	// Event is the event type, from the X-GitHub-Event header.
+	Event string
+
+	// Payload is the JSON payload of the delivery.
+	Payload []byte
+}
+
+/*
+WebHookHandler is an http.Handler that receives webhook deliveries from
+GitHub and passes the events they carry to the callbacks registered for their
+type:
+
+	h := &github.WebHookHandler{Secret: "s3cr3t"}
+	h.OnPullRequest(func(d *github.WebHookDelivery, e *github.PullRequestEvent) error {
+		log.Printf("pull request #%d %v", *e.Number, *e.Action)
+		return nil
+	})
+	http.Handle("/hooks", h)
+
+If Secret is set, every delivery must be signed with it, and deliveries with a
+missing or invalid signature are rejected with 403 Forbidden.  A delivery
+whose ID or body has already been received is rejected with 409 Conflict, so
+that a captured delivery cannot be replayed, even under a new ID.  Deliveries
+of event types without a callback are acknowledged and otherwise ignored.  If
+a callback returns an error, the handler answers 500 Internal Server Error,
+and the delivery can be redelivered from the hook's settings.
+
+The zero value is ready to use, though without a Secret anyone can send
+deliveries.  A WebHookHandler is safe for concurrent use, and callbacks may be
+called concurrently.
+
+GitHub API docs: http://developer.github.com/webhooks/
+*/
+type WebHookHandler struct {
+	// Secret is the secret configured for the hook.
+	Secret string
+
+	// RememberedDeliveries is the number of most recent deliveries whose ID
+	// and body digest are kept to reject replays.  If zero, 10000 are kept.
+	RememberedDeliveries int
+
+	mu        sync.Mutex
+	callbacks map[string]func(*WebHookDelivery, interface{}) error
+	delivered map[string]bool // delivery IDs and body digests
+	order     []deliveryKey   // oldest first
+}
+
+// deliveryKey identifies a received delivery by its ID and by the digest of
+// its body.  The signature does not cover the X-GitHub-Delivery header, so a
+// captured body could otherwise be replayed under a new ID.
+type deliveryKey struct {
+	id     string
+	digest string
+}
+
+// newDeliveryKey returns the deliveryKey of the delivery with the given ID
+// and body.
+func newDeliveryKey(id string, body []byte) deliveryKey {
+	sum := sha256.Sum256(body)
+	return deliveryKey{id: "id:" + id, digest: "sha256:" + hex.EncodeToString(sum[:])}
+}
+
+// on registers f as the callback for event type eventType, replacing any
+// previous one.
+func (h *WebHookHandler) on(eventType string, f func(*WebHookDelivery, interface{}) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.callbacks == nil {
+		h.callbacks = make(map[string]func(*WebHookDelivery, interface{}) error)
+	}
+	h.callbacks[eventType] = f
+}
+
+// OnPush registers f to be called for push events.
+func (h *WebHookHandler) OnPush(f func(*WebHookDelivery, *WebHookPayload) error) {
+	h.on("push", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*WebHookPayload)) })
+}
+
+// OnPullRequest registers f to be called for pull_request events.
+func (h *WebHookHandler) OnPullRequest(f func(*WebHookDelivery, *PullRequestEvent) error) {
+	h.on("pull_request", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*PullRequestEvent)) })
+}
+
+// OnIssues registers f to be called for issues events.
+func (h *WebHookHandler) OnIssues(f func(*WebHookDelivery, *IssuesEvent) error) {
+	h.on("issues", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*IssuesEvent)) })
+}
+
+// OnIssueComment registers f to be called for issue_comment events.
+func (h *WebHookHandler) OnIssueComment(f func(*WebHookDelivery, *IssueCommentEvent) error) {
+	h.on("issue_comment", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*IssueCommentEvent)) })
+}
+
+// OnStatus registers f to be called for status events.
+func (h *WebHookHandler) OnStatus(f func(*WebHookDelivery, *StatusEvent) error) {
+	h.on("status", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*StatusEvent)) })
+}
+
+// OnCreate registers f to be called for create events.
+func (h *WebHookHandler) OnCreate(f func(*WebHookDelivery, *CreateEvent) error) {
+	h.on("create", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*CreateEvent)) })
+}
+
+// OnDelete registers f to be called for delete events.
+func (h *WebHookHandler) OnDelete(f func(*WebHookDelivery, *DeleteEvent) error) {
+	h.on("delete", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*DeleteEvent)) })
+}
+
+// OnPing registers f to be called for ping events.
+func (h *WebHookHandler) OnPing(f func(*WebHookDelivery, *PingEvent) error) {
+	h.on("ping", func(d *WebHookDelivery, e interface{}) error { return f(d, e.(*PingEvent)) })
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WebHookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	d := &WebHookDelivery{
+		ID:    r.Header.Get(headerDelivery),
+		Event: r.Header.Get(headerEvent),
+	}
+	if d.ID == "" || d.Event == "" {
+		http.Error(w, "missing "+headerDelivery+" or "+headerEvent+" header", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebHookPayload))
+	if err != nil {
+		http.Error(w, "cannot read payload", http.StatusBadRequest)
+		return
+	}
+
+	if h.Secret != "" {
+		signature := r.Header.Get(headerSignature256)
+		if signature == "" {
+			signature = r.Header.Get(headerSignature)
+		}
+		if err := ValidateSignature(signature, body, []byte(h.Secret)); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	// Hooks can be configured to send the payload as a form parameter.
+	d.Payload = body
+	if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			http.Error(w, "cannot parse form", http.StatusBadRequest)
+			return
+		}
+		d.Payload = []byte(form.Get("payload"))
+	}
+
+	h.mu.Lock()
+	f := h.callbacks[d.Event]
+	h.mu.Unlock()
+
+	var event interface{}
+	if f != nil {
+		if event, err = ParseWebHook(d.Event, d.Payload); err != nil {
+			http.Error(w, "cannot parse payload", http.StatusBadRequest)
+			return
+		}
+	}
+
+	key := newDeliveryKey(d.ID, body)
+	if !h.markDelivered(key) {
+		http.Error(w, ErrReplayedDelivery.Error(), http.StatusConflict)
+		return
+	}
+	if f != nil {
+		if err := f(d, event); err != nil {
+			// Let GitHub redeliver the event.
+			h.forgetDelivery(key)
+			http.Error(w, "webhook callback failed", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// markDelivered records that the delivery identified by key was received.
+// It returns false if a delivery with the same ID or body had already been.
+func (h *WebHookHandler) markDelivered(key deliveryKey) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.delivered[key.id] || h.delivered[key.digest] {
+		return false
+	}
+	if h.delivered == nil {
+		h.delivered = make(map[string]bool)
+	}
+	h.delivered[key.id] = true
+	h.delivered[key.digest] = true
+	h.order = append(h.order, key)
+
+	max := h.RememberedDeliveries
+	if max <= 0 {
+		max = defaultRememberedDeliveries
+	}
+	for len(h.order) > max {
+		delete(h.delivered, h.order[0].id)
+		delete(h.delivered, h.order[0].digest)
+		h.order = h.order[1:]
+	}
+	return true
+}
+
+// forgetDelivery removes the delivery identified by key from those received,
+// so that it is accepted if redelivered.
+func (h *WebHookHandler) forgetDelivery(key deliveryKey) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.delivered, key.id)
+	delete(h.delivered, key.digest)
+	for i, v := range h.order {
+		if v == key {
+			h.order = append(h.order[:i], h.order[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sign returns the X-Hub-Signature of body keyed with secret.
+func sign(body, secret string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliver sends a webhook delivery to h and returns the response status.
+func deliver(h http.Handler, id, event, body, signature string) int {
+	req := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerDelivery, id)
+	req.Header.Set(headerEvent, event)
+	if signature != "" {
+		req.Header.Set(headerSignature, signature)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestValidateSignature(t *testing.T) {
+	body := []byte(`{"zen":"z"}`)
+	mac := hmac.New(sha256.New, []byte("s"))
+	mac.Write(body)
+	sha256Signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		signature string
+		err       error
+	}{
+		{sign(string(body), "s"), nil},
+		{sha256Signature, nil},
+		{"sha1=" + strings.TrimPrefix(sha256Signature, "sha256="), ErrInvalidSignature},
+		{sign(string(body), "other"), ErrInvalidSignature},
+		{"sha1=zz", ErrInvalidSignature},
+		{"md5=00", ErrInvalidSignature},
+		{"", ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		if err := ValidateSignature(tt.signature, body, []byte("s")); err != tt.err {
+			t.Errorf("ValidateSignature(%q) returned %v, want %v", tt.signature, err, tt.err)
+		}
+	}
+}
+
+func TestParseWebHook(t *testing.T) {
+	tests := []struct {
+		event   string
+		payload string
+		want    interface{}
+	}{
+		{"push", `{"ref":"refs/heads/master"}`, &WebHookPayload{Ref: String("refs/heads/master")}},
+		{"pull_request", `{"action":"opened","number":1}`, &PullRequestEvent{Action: String("opened"), Number: Int(1)}},
+		{"issues", `{"action":"closed","issue":{"number":2}}`, &IssuesEvent{Action: String("closed"), Issue: &Issue{Number: Int(2)}}},
+		{"issue_comment", `{"comment":{"id":3}}`, &IssueCommentEvent{Comment: &IssueComment{ID: Int(3)}}},
+		{"status", `{"sha":"s","state":"success"}`, &StatusEvent{SHA: String("s"), State: String("success")}},
+		{"create", `{"ref":"v1","ref_type":"tag"}`, &CreateEvent{Ref: String("v1"), RefType: String("tag")}},
+		{"delete", `{"ref":"b","ref_type":"branch"}`, &DeleteEvent{Ref: String("b"), RefType: String("branch")}},
+		{"ping", `{"zen":"z","hook_id":4}`, &PingEvent{Zen: String("z"), HookID: Int(4)}},
+	}
+	for _, tt := range tests {
+		got, err := ParseWebHook(tt.event, []byte(tt.payload))
+		if err != nil {
+			t.Errorf("ParseWebHook(%q) returned error: %v", tt.event, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseWebHook(%q) returned %+v, want %+v", tt.event, got, tt.want)
+		}
+	}
+
+	if _, err := ParseWebHook("unknown", []byte(`{}`)); err == nil {
+		t.Error("ParseWebHook returned no error for an unknown event type")
+	}
+	if _, err := ParseWebHook("ping", []byte(`{`)); err == nil {
+		t.Error("ParseWebHook returned no error for invalid JSON")
+	}
+}
+
+func TestWebHookHandler(t *testing.T) {
+	h := &WebHookHandler{Secret: "s"}
+	var got []*PullRequestEvent
+	h.OnPullRequest(func(d *WebHookDelivery, e *PullRequestEvent) error {
+		if d.ID != "1" || d.Event != "pull_request" {
+			t.Errorf("callback got delivery %+v", d)
+		}
+		got = append(got, e)
+		return nil
+	})
+
+	body := `{"action":"opened","number":1}`
+	if code := deliver(h, "1", "pull_request", body, sign(body, "s")); code != http.StatusNoContent {
+		t.Errorf("delivery returned status %v, want %v", code, http.StatusNoContent)
+	}
+	want := []*PullRequestEvent{{Action: String("opened"), Number: Int(1)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("callback got %+v, want %+v", got, want)
+	}
+
+	// A replayed delivery is rejected, even under a new delivery ID, which
+	// the signature does not cover.
+	if code := deliver(h, "1", "pull_request", body, sign(body, "s")); code != http.StatusConflict {
+		t.Errorf("replayed delivery returned status %v, want %v", code, http.StatusConflict)
+	}
+	if code := deliver(h, "5", "pull_request", body, sign(body, "s")); code != http.StatusConflict {
+		t.Errorf("replayed body with a new ID returned status %v, want %v", code, http.StatusConflict)
+	}
+
+	// Unsigned and wrongly signed deliveries are rejected.
+	if code := deliver(h, "2", "pull_request", body, ""); code != http.StatusForbidden {
+		t.Errorf("unsigned delivery returned status %v, want %v", code, http.StatusForbidden)
+	}
+	if code := deliver(h, "3", "pull_request", body, sign(body, "wrong")); code != http.StatusForbidden {
+		t.Errorf("wrongly signed delivery returned status %v, want %v", code, http.StatusForbidden)
+	}
+
+	// Events without a callback are acknowledged.
+	if code := deliver(h, "4", "watch", `{}`, sign(`{}`, "s")); code != http.StatusNoContent {
+		t.Errorf("unhandled delivery returned status %v, want %v", code, http.StatusNoContent)
+	}
+
+	if len(got) != 1 {
+		t.Errorf("callback called %v times, want 1", len(got))
+	}
+}
+
+func TestWebHookHandler_callbackError(t *testing.T) {
+	h := new(WebHookHandler)
+	fail := true
+	h.OnPing(func(d *WebHookDelivery, e *PingEvent) error {
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if code := deliver(h, "1", "ping", `{}`, ""); code != http.StatusInternalServerError {
+		t.Errorf("delivery returned status %v, want %v", code, http.StatusInternalServerError)
+	}
+
+	// The failed delivery can be redelivered.
+	fail = false
+	if code := deliver(h, "1", "ping", `{}`, ""); code != http.StatusNoContent {
+		t.Errorf("redelivery returned status %v, want %v", code, http.StatusNoContent)
+	}
+}
+
+func TestWebHookHandler_formPayload(t *testing.T) {
+	h := &WebHookHandler{Secret: "s"}
+	var got *WebHookPayload
+	h.OnPush(func(d *WebHookDelivery, e *WebHookPayload) error {
+		got = e
+		return nil
+	})
+
+	body := url.Values{"payload": {`{"ref":"r"}`}}.Encode()
+	req := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(headerDelivery, "1")
+	req.Header.Set(headerEvent, "push")
+	req.Header.Set(headerSignature, sign(body, "s"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("delivery returned status %v, want %v", w.Code, http.StatusNoContent)
+	}
+	if want := (&WebHookPayload{Ref: String("r")}); !reflect.DeepEqual(got, want) {
+		t.Errorf("callback got %+v, want %+v", got, want)
+	}
+}
+
+func TestWebHookHandler_badRequests(t *testing.T) {
+	h := new(WebHookHandler)
+	h.OnPing(func(d *WebHookDelivery, e *PingEvent) error { return nil })
+
+	if code := deliver(h, "", "ping", `{}`, ""); code != http.StatusBadRequest {
+		t.Errorf("delivery without ID returned status %v, want %v", code, http.StatusBadRequest)
+	}
+	if code := deliver(h, "1", "ping", `{`, ""); code != http.StatusBadRequest {
+		t.Errorf("delivery with invalid JSON returned status %v, want %v", code, http.StatusBadRequest)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/hook", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET returned status %v, want %v", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestWebHookHandler_rememberedDeliveries(t *testing.T) {
+	h := &WebHookHandler{RememberedDeliveries: 2}
+	body := func(id string) string { return `{"hook_id":` + id + `}` }
+	for _, id := range []string{"1", "2", "3"} {
+		deliver(h, id, "ping", body(id), "")
+	}
+	if code := deliver(h, "1", "ping", body("1"), ""); code != http.StatusNoContent {
+		t.Errorf("forgotten delivery returned status %v, want %v", code, http.StatusNoContent)
+	}
+	if code := deliver(h, "3", "ping", body("3"), ""); code != http.StatusConflict {
+		t.Errorf("remembered delivery returned status %v, want %v", code, http.StatusConflict)
+	}
+	if code := deliver(h, "4", "ping", body("3"), ""); code != http.StatusConflict {
+		t.Errorf("remembered body returned status %v, want %v", code, http.StatusConflict)
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode specifies whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// Replay answers requests from the interactions in the cassette file,
+	// without sending them.
+	Replay Mode = iota
+
+	// Record sends requests and records the interactions, to be written to
+	// the cassette file by Save.
+	Record
+)
+
+// redacted replaces credentials in recorded interactions.
+const redacted = "REDACTED"
+
+// scrubbedParams are the query parameters whose values are credentials.
+var scrubbedParams = []string{"access_token", "client_secret"}
+
+// A Recorder is an http.RoundTripper that records the requests sent through
+// it and their responses to a cassette file, and replays them later, so that
+// tests run against real GitHub responses without network access.
+//
+// Credentials are never written to the cassette: the Authorization header is
+// dropped, and the values of the client_secret and access_token query
+// parameters are replaced by "REDACTED", both in request URLs and in the URLs
+// of Link and Location response headers.  To record requests after the
+// credentials have been added, use the Recorder as the underlying transport
+// of the authenticating one:
+//
+//	rec, err := githubtest.NewRecorder("testdata/repos.json", githubtest.Record, nil)
+//	t := &github.UnauthenticatedRateLimitedTransport{
+//		ClientID:     "id",
+//		ClientSecret: "secret",
+//		Transport:    rec,
+//	}
+//	client := github.NewClient(t.Client())
+//	// ... make requests ...
+//	err = rec.Save()
+//
+// In Replay mode, each request is answered with the first interaction not yet
+// replayed with the same method, URL and body, so repeated requests replay in
+// the order they were recorded.  A request matching none of them fails with
+// an *UnmatchedRequestError.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// An Interaction is a request and the response it received, as stored in a
+// cassette file.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request stored in a cassette file.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response stored in a cassette file.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is the body of a request or response.  It is stored in cassette files
+// as a string if it is valid UTF-8, so that JSON bodies remain readable, and
+// as base64 otherwise.
+type Body []byte
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	d, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	*b = d
+	return err
+}
+
+// UnmatchedRequestError is returned by a Recorder in Replay mode for a
+// request that matches no interaction in the cassette.
+type UnmatchedRequestError struct {
+	Cassette string // path of the cassette file
+	Method   string
+	URL      string // with credentials scrubbed
+	Body     []byte
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	msg := fmt.Sprintf("githubtest: no interaction in cassette %v matches %v %v", e.Cassette, e.Method, e.URL)
+	if len(e.Body) > 0 {
+		msg += fmt.Sprintf(" with body %q", e.Body)
+	}
+	return msg + "; record the cassette again if the requests have changed"
+}
+
+// NewRecorder returns a Recorder for the cassette file at path.  In Replay
+// mode the file is loaded, and an error is returned if it cannot be read.  In
+// Record mode requests are sent with transport, or http.DefaultTransport if
+// nil.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, transport: transport}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+
+	if mode == Replay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("githubtest: cassette %v: %v", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+	return r, nil
+}
+
+// Client returns an *http.Client that sends its requests through r.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.mode == Replay {
+		return r.replay(req, body)
+	}
+	return r.record(req, body)
+}
+
+// replay answers req from the first unplayed interaction matching it.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	u := scrubURL(req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.interactions {
+		if r.replayed[i] || in.Request.Method != req.Method || in.Request.URL != u || !bytes.Equal(in.Request.Body, body) {
+			continue
+		}
+		r.replayed[i] = true
+
+		header := make(http.Header)
+		for k, v := range in.Response.Header {
+			header[k] = v
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %v", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, &UnmatchedRequestError{Cassette: r.path, Method: req.Method, URL: u, Body: body}
+}
+
+// record sends req and records the interaction.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	// Send a copy, so that req itself is not modified.
+	out := new(http.Request)
+	*out = *req
+	if req.Body != nil {
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	header := make(http.Header)
+	for k, v := range req.Header {
+		if k != "Authorization" {
+			header[k] = v
+		}
+	}
+	in := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    scrubURL(req.URL),
+			Header: header,
+			Body:   body,
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubResponseHeader(resp.Header),
+			Body:       respBody,
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, in)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// Save writes the recorded interactions to the cassette file, replacing it.
+// It does nothing in Replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// Unplayed returns the interactions in the cassette that have not been
+// replayed, so that tests can check that every recorded request was made.
+func (r *Recorder) Unplayed() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unplayed []*Interaction
+	for i, in := range r.interactions {
+		if r.mode == Replay && !r.replayed[i] {
+			unplayed = append(unplayed, in)
+		}
+	}
+	return unplayed
+}
+
+// linkURL matches the URLs of a Link header.
+var linkURL = regexp.MustCompile(`<[^>]*>`)
+
+// scrubResponseHeader returns a copy of h, with credentials scrubbed from the
+// URLs of its Link and Location headers, which GitHub builds from the request
+// URL.
+func scrubResponseHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for i, v := range h["Link"] {
+		h["Link"][i] = linkURL.ReplaceAllStringFunc(v, func(l string) string {
+			u, err := url.Parse(l[1 : len(l)-1])
+			if err != nil {
+				return l
+			}
+			return "<" + scrubURL(u) + ">"
+		})
+	}
+	for i, v := range h["Location"] {
+		if u, err := url.Parse(v); err == nil {
+			h["Location"][i] = scrubURL(u)
+		}
+	}
+	return h
+}
+
+// scrubURL returns u as a string, with the values of credential query
+// parameters replaced.
+func scrubURL(u *url.URL) string {
+	q := u.Query()
+	scrubbed := false
+	for _, p := range scrubbedParams {
+		if _, ok := q[p]; ok {
+			q.Set(p, redacted)
+			scrubbed = true
+		}
+	}
+	if !scrubbed {
+		return u.String()
+	}
+
+	s := *u
+	s.RawQuery = q.Encode()
+	return s.String()
+}
@@ -0,0 +1,187 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+// recordingClient returns a client that authenticates with both a token and
+// a client secret, and sends its requests through rec to srv.
+func recordingClient(srv *Server, rec *Recorder) *github.Client {
+	t := &github.UnauthenticatedRateLimitedTransport{
+		ClientID:     "id",
+		ClientSecret: "s3cr3t",
+		Transport:    &github.TokenAuthTransport{Token: "t0k3n", Transport: rec},
+	}
+	client := srv.Client()
+	base, upload := client.BaseURL, client.UploadURL
+	client = github.NewClient(t.Client())
+	client.BaseURL, client.UploadURL = base, upload
+	return client
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "githubtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	srv := NewServer()
+	srv.AddRepo("o", "r")
+
+	rec, err := NewRecorder(path, Record, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := recordingClient(srv, rec)
+	want, _, err := client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("t")})
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if _, _, err := client.Issues.Get(ctx, "o", "r", *want.Number); err != nil {
+		t.Fatalf("Issues.Get returned error: %v", err)
+	}
+	if _, _, err := client.Issues.Get(ctx, "o", "r", 99); !errors.Is(err, github.ErrNotFound) {
+		t.Fatalf("Issues.Get returned error %v, want ErrNotFound", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	srv.Close()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"s3cr3t", "t0k3n", "Authorization"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+	if !bytes.Contains(data, []byte("client_secret=REDACTED")) {
+		t.Errorf("cassette does not contain scrubbed client_secret:\n%s", data)
+	}
+
+	// Replay, with the server shut down.
+	rec, err = NewRecorder(path, Replay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client = recordingClient(srv, rec)
+	issue, _, err := client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("t")})
+	if err != nil {
+		t.Fatalf("replayed Issues.Create returned error: %v", err)
+	}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("replayed Issues.Create returned %+v, want %+v", issue, want)
+	}
+	if len(rec.Unplayed()) != 2 {
+		t.Errorf("Unplayed returned %v interactions, want 2", len(rec.Unplayed()))
+	}
+	if _, _, err := client.Issues.Get(ctx, "o", "r", *want.Number); err != nil {
+		t.Errorf("replayed Issues.Get returned error: %v", err)
+	}
+	if _, _, err := client.Issues.Get(ctx, "o", "r", 99); !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("replayed Issues.Get returned error %v, want ErrNotFound", err)
+	}
+
+	// A repeated request, and a request with a different body, are unknown.
+	_, _, err = client.Issues.Get(ctx, "o", "r", *want.Number)
+	var unmatched *UnmatchedRequestError
+	if !errors.As(err, &unmatched) {
+		t.Fatalf("repeated Issues.Get returned error %v, want *UnmatchedRequestError", err)
+	}
+	if unmatched.Method != "GET" || strings.Contains(unmatched.URL, "s3cr3t") {
+		t.Errorf("UnmatchedRequestError = %+v", unmatched)
+	}
+	_, _, err = client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("other")})
+	if !errors.As(err, &unmatched) {
+		t.Errorf("Issues.Create with another body returned error %v, want *UnmatchedRequestError", err)
+	}
+}
+
+func TestRecorder_scrubsResponseLinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "githubtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	for i := 0; i < 3; i++ {
+		label := &github.Label{Name: github.String(fmt.Sprint("l", i)), Color: github.String("ff0000")}
+		if _, _, err := srv.Client().Issues.CreateLabel(ctx, "o", "r", label); err != nil {
+			t.Fatalf("CreateLabel returned error: %v", err)
+		}
+	}
+
+	rec, err := NewRecorder(path, Record, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := recordingClient(srv, rec)
+	_, resp, err := client.Issues.ListLabels(ctx, "o", "r", &github.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListLabels returned error: %v", err)
+	}
+	if resp.NextPage != 2 {
+		t.Errorf("ListLabels returned next page %v, want 2", resp.NextPage)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("Link")) {
+		t.Fatalf("cassette does not contain the Link header:\n%s", data)
+	}
+	if bytes.Contains(data, []byte("s3cr3t")) {
+		t.Errorf("cassette contains the client secret:\n%s", data)
+	}
+}
+
+func TestRecorder_missingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join("testdata", "missing.json"), Replay, nil); err == nil {
+		t.Error("NewRecorder returned no error for a missing cassette")
+	}
+}
+
+func TestBody_JSON(t *testing.T) {
+	for _, b := range []Body{Body(`{"a":"ü"}`), {0xff, 0x00, 0xfe}} {
+		in := &Interaction{Response: RecordedResponse{Body: b}}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal returned error: %v", err)
+		}
+		out := new(Interaction)
+		if err := json.Unmarshal(data, out); err != nil {
+			t.Fatalf("Unmarshal returned error: %v", err)
+		}
+		if !bytes.Equal(out.Response.Body, b) {
+			t.Errorf("Body %q round-tripped as %q", b, out.Response.Body)
+		}
+	}
+}
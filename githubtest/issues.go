@@ -0,0 +1,552 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// issue is an issue and the names of its labels.  Labels are rendered from
+// the labels of the repository, so that renaming a label renames it on every
+// issue.
+type issue struct {
+	github.Issue
+	labels []string
+}
+
+// comment is a comment on the issue with the given number.
+type comment struct {
+	github.IssueComment
+	issue int
+}
+
+// renderIssue returns the API representation of i.
+func (rp *repo) renderIssue(i *issue) github.Issue {
+	v := i.Issue
+	v.Labels = []github.Label{}
+	for _, name := range i.labels {
+		if l := rp.label(name); l != nil {
+			v.Labels = append(v.Labels, *l)
+		}
+	}
+	n := 0
+	for _, c := range rp.comments {
+		if c.issue == *i.Number {
+			n++
+		}
+	}
+	v.Comments = github.Int(n)
+	return v
+}
+
+// label returns the label with the given name, or nil.
+func (rp *repo) label(name string) *github.Label {
+	for _, l := range rp.labels {
+		if strings.EqualFold(*l.Name, name) {
+			return l
+		}
+	}
+	return nil
+}
+
+// ensureLabel returns the label with the given name, creating it with a
+// default color if needed, as GitHub does when labeling an issue.
+func (s *Server) ensureLabel(rp *repo, name string) *github.Label {
+	if l := rp.label(name); l != nil {
+		return l
+	}
+	l := &github.Label{
+		URL:   github.String(s.URL + "/repos/" + *rp.Owner.Login + "/" + *rp.Name + "/labels/" + name),
+		Name:  github.String(name),
+		Color: github.String("ededed"),
+	}
+	rp.labels = append(rp.labels, l)
+	return l
+}
+
+// filterByState returns the numbers in numbers whose state matches the state
+// query parameter of r: "open" (the default), "closed" or "all".  They are
+// sorted in the order given by the direction parameter, newest first by
+// default.
+func filterByState(r *http.Request, numbers []int, state func(int) string) []int {
+	want := r.URL.Query().Get("state")
+	if want == "" {
+		want = "open"
+	}
+	var out []int
+	for _, n := range numbers {
+		if want == "all" || state(n) == want {
+			out = append(out, n)
+		}
+	}
+	if r.URL.Query().Get("direction") == "asc" {
+		sort.Ints(out)
+	} else {
+		sort.Sort(sort.Reverse(sort.IntSlice(out)))
+	}
+	return out
+}
+
+func (s *Server) addIssueRoutes() {
+	s.handle("GET", "repos/:owner/:repo/issues", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		var numbers []int
+		for n := range rp.issues {
+			numbers = append(numbers, n)
+		}
+		numbers = filterByState(r, numbers, func(n int) string { return *rp.issues[n].State })
+
+		q := r.URL.Query()
+		var labels []string
+		if q.Get("labels") != "" {
+			labels = strings.Split(q.Get("labels"), ",")
+		}
+		assignee := q.Get("assignee")
+
+		issues := []github.Issue{}
+	outer:
+		for _, n := range numbers {
+			i := rp.issues[n]
+			for _, l := range labels {
+				if !containsFold(i.labels, l) {
+					continue outer
+				}
+			}
+			switch {
+			case assignee == "" || assignee == "*" && i.Assignee != nil:
+			case assignee == "none" && i.Assignee == nil:
+			case i.Assignee != nil && strings.EqualFold(*i.Assignee.Login, assignee):
+			default:
+				continue outer
+			}
+			issues = append(issues, rp.renderIssue(i))
+		}
+		writeList(w, r, issues)
+	}))
+
+	s.handle("POST", "repos/:owner/:repo/issues", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		body := new(issueBody)
+		if !decode(w, r, body) {
+			return
+		}
+		if body.Title == nil || *body.Title == "" {
+			validationFailed(w, "Issue", "title", "missing_field")
+			return
+		}
+		i := &issue{}
+		if !s.applyIssueEdit(w, rp, i, body) {
+			return
+		}
+		rp.lastNumber++
+		i.Number = github.Int(rp.lastNumber)
+		i.State = github.String("open")
+		i.User = s.user(s.login)
+		i.CreatedAt, i.UpdatedAt = nowPtr(), nowPtr()
+		rp.issues[*i.Number] = i
+		writeJSON(w, http.StatusCreated, rp.renderIssue(i))
+	}))
+
+	// Registered before the routes taking an issue number, which would
+	// otherwise match it.
+	s.handle("GET", "repos/:owner/:repo/issues/comments", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		comments := []github.IssueComment{}
+		for _, c := range rp.comments {
+			comments = append(comments, c.IssueComment)
+		}
+		writeList(w, r, comments)
+	}))
+
+	s.handle("GET", "repos/:owner/:repo/issues/:number", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		writeJSON(w, http.StatusOK, rp.renderIssue(i))
+	}))
+	s.handle("PATCH", "repos/:owner/:repo/issues/:number", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		body := new(issueBody)
+		if !decode(w, r, body) {
+			return
+		}
+		if body.Title != nil && *body.Title == "" {
+			validationFailed(w, "Issue", "title", "missing_field")
+			return
+		}
+		if body.State != nil && *body.State != "open" && *body.State != "closed" {
+			validationFailed(w, "Issue", "state", "invalid")
+			return
+		}
+		if !s.applyIssueEdit(w, rp, i, body) {
+			return
+		}
+		if body.State != nil && *body.State != *i.State {
+			i.State = body.State
+			if *i.State == "closed" {
+				i.ClosedAt = nowPtr()
+			} else {
+				i.ClosedAt = nil
+			}
+		}
+		i.UpdatedAt = nowPtr()
+		writeJSON(w, http.StatusOK, rp.renderIssue(i))
+	}))
+
+	s.addCommentRoutes()
+	s.addLabelRoutes()
+}
+
+// issueBody is the body of a request creating or editing an issue.  Labels
+// may be given either as names, as GitHub documents, or as label objects, as
+// github.Issue encodes them.
+type issueBody struct {
+	Title    *string           `json:"title"`
+	Body     *string           `json:"body"`
+	State    *string           `json:"state"`
+	Assignee json.RawMessage   `json:"assignee"`
+	Labels   []json.RawMessage `json:"labels"`
+}
+
+// applyIssueEdit applies the title, body, assignee and labels in body to i.
+// If the assignee is not a user, it writes a validation error and returns
+// false.
+func (s *Server) applyIssueEdit(w http.ResponseWriter, rp *repo, i *issue, body *issueBody) bool {
+	if len(body.Assignee) > 0 {
+		var login string
+		if json.Unmarshal(body.Assignee, &login) != nil {
+			var user github.User
+			if json.Unmarshal(body.Assignee, &user) == nil && user.Login != nil {
+				login = *user.Login
+			}
+		}
+		if login == "" {
+			i.Assignee = nil
+		} else if u := s.user(login); u != nil {
+			i.Assignee = u
+		} else {
+			validationFailed(w, "Issue", "assignee", "invalid")
+			return false
+		}
+	}
+	if body.Title != nil {
+		i.Title = body.Title
+	}
+	if body.Body != nil {
+		i.Body = body.Body
+	}
+	if body.Labels != nil {
+		i.labels = nil
+		for _, raw := range body.Labels {
+			var name string
+			var label github.Label
+			if json.Unmarshal(raw, &name) != nil && json.Unmarshal(raw, &label) == nil && label.Name != nil {
+				name = *label.Name
+			}
+			if name != "" && !containsFold(i.labels, name) {
+				i.labels = append(i.labels, *s.ensureLabel(rp, name).Name)
+			}
+		}
+	}
+	return true
+}
+
+func (s *Server) addCommentRoutes() {
+	s.handle("GET", "repos/:owner/:repo/issues/:number/comments", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		comments := []github.IssueComment{}
+		for _, c := range rp.comments {
+			if c.issue == *i.Number {
+				comments = append(comments, c.IssueComment)
+			}
+		}
+		writeList(w, r, comments)
+	}))
+	s.handle("POST", "repos/:owner/:repo/issues/:number/comments", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		c := &comment{issue: *i.Number}
+		if !decode(w, r, &c.IssueComment) {
+			return
+		}
+		if c.Body == nil || *c.Body == "" {
+			validationFailed(w, "IssueComment", "body", "missing_field")
+			return
+		}
+		c.ID = github.Int(s.newID())
+		c.User = s.user(s.login)
+		c.CreatedAt, c.UpdatedAt = nowPtr(), nowPtr()
+		rp.comments = append(rp.comments, c)
+		writeJSON(w, http.StatusCreated, &c.IssueComment)
+	}))
+	s.handle("GET", "repos/:owner/:repo/issues/comments/:id", s.withComment(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, c *comment) {
+		writeJSON(w, http.StatusOK, &c.IssueComment)
+	}))
+	s.handle("PATCH", "repos/:owner/:repo/issues/comments/:id", s.withComment(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, c *comment) {
+		edit := new(github.IssueComment)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Body == nil || *edit.Body == "" {
+			validationFailed(w, "IssueComment", "body", "missing_field")
+			return
+		}
+		c.Body = edit.Body
+		c.UpdatedAt = nowPtr()
+		writeJSON(w, http.StatusOK, &c.IssueComment)
+	}))
+	s.handle("DELETE", "repos/:owner/:repo/issues/comments/:id", s.withComment(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, c *comment) {
+		for i, v := range rp.comments {
+			if v == c {
+				rp.comments = append(rp.comments[:i], rp.comments[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// labelColor matches the valid colors of a label.
+var labelColor = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+func (s *Server) addLabelRoutes() {
+	s.handle("GET", "repos/:owner/:repo/labels", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		labels := []github.Label{}
+		for _, l := range rp.labels {
+			labels = append(labels, *l)
+		}
+		// GitHub lists labels by name.
+		sort.Slice(labels, func(i, j int) bool {
+			return strings.ToLower(*labels[i].Name) < strings.ToLower(*labels[j].Name)
+		})
+		writeList(w, r, labels)
+	}))
+	s.handle("POST", "repos/:owner/:repo/labels", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		l := new(github.Label)
+		if !decode(w, r, l) {
+			return
+		}
+		switch {
+		case l.Name == nil || *l.Name == "":
+			validationFailed(w, "Label", "name", "missing_field")
+		case l.Color == nil:
+			validationFailed(w, "Label", "color", "missing_field")
+		case !labelColor.MatchString(*l.Color):
+			validationFailed(w, "Label", "color", "invalid")
+		case rp.label(*l.Name) != nil:
+			validationFailed(w, "Label", "name", "already_exists")
+		default:
+			nl := s.ensureLabel(rp, *l.Name)
+			nl.Color = l.Color
+			writeJSON(w, http.StatusCreated, nl)
+		}
+	}))
+	s.handle("GET", "repos/:owner/:repo/labels/:name", s.withLabel(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, l *github.Label) {
+		writeJSON(w, http.StatusOK, l)
+	}))
+	s.handle("PATCH", "repos/:owner/:repo/labels/:name", s.withLabel(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, l *github.Label) {
+		edit := new(github.Label)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Color != nil && !labelColor.MatchString(*edit.Color) {
+			validationFailed(w, "Label", "color", "invalid")
+			return
+		}
+		if edit.Name != nil && !strings.EqualFold(*edit.Name, *l.Name) {
+			if *edit.Name == "" {
+				validationFailed(w, "Label", "name", "missing_field")
+				return
+			}
+			if rp.label(*edit.Name) != nil {
+				validationFailed(w, "Label", "name", "already_exists")
+				return
+			}
+			for _, i := range rp.issues {
+				for j, name := range i.labels {
+					if strings.EqualFold(name, *l.Name) {
+						i.labels[j] = *edit.Name
+					}
+				}
+			}
+			l.Name = edit.Name
+			l.URL = github.String(s.URL + "/repos/" + *rp.Owner.Login + "/" + *rp.Name + "/labels/" + *l.Name)
+		}
+		if edit.Color != nil {
+			l.Color = edit.Color
+		}
+		writeJSON(w, http.StatusOK, l)
+	}))
+	s.handle("DELETE", "repos/:owner/:repo/labels/:name", s.withLabel(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, l *github.Label) {
+		for i, v := range rp.labels {
+			if v == l {
+				rp.labels = append(rp.labels[:i], rp.labels[i+1:]...)
+				break
+			}
+		}
+		for _, i := range rp.issues {
+			i.labels = removeFold(i.labels, *l.Name)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	s.handle("GET", "repos/:owner/:repo/issues/:number/labels", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		writeList(w, r, rp.renderIssue(i).Labels)
+	}))
+	setLabels := func(replace bool) func(http.ResponseWriter, *http.Request, params) {
+		return s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+			var names []string
+			if !decode(w, r, &names) {
+				return
+			}
+			if replace {
+				i.labels = nil
+			}
+			for _, name := range names {
+				if !containsFold(i.labels, name) {
+					i.labels = append(i.labels, *s.ensureLabel(rp, name).Name)
+				}
+			}
+			writeJSON(w, http.StatusOK, rp.renderIssue(i).Labels)
+		})
+	}
+	s.handle("POST", "repos/:owner/:repo/issues/:number/labels", setLabels(false))
+	s.handle("PUT", "repos/:owner/:repo/issues/:number/labels", setLabels(true))
+	s.handle("DELETE", "repos/:owner/:repo/issues/:number/labels", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		i.labels = nil
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.handle("DELETE", "repos/:owner/:repo/issues/:number/labels/:name", s.withIssue(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue) {
+		if !containsFold(i.labels, p["name"]) {
+			notFound(w)
+			return
+		}
+		i.labels = removeFold(i.labels, p["name"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func (s *Server) addPullRoutes() {
+	s.handle("GET", "repos/:owner/:repo/pulls", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		var numbers []int
+		for n := range rp.pulls {
+			numbers = append(numbers, n)
+		}
+		pulls := []github.PullRequest{}
+		for _, n := range filterByState(r, numbers, func(n int) string { return *rp.pulls[n].State }) {
+			pulls = append(pulls, *rp.pulls[n])
+		}
+		writeList(w, r, pulls)
+	}))
+	s.handle("POST", "repos/:owner/:repo/pulls", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		pr := new(github.PullRequest)
+		if !decode(w, r, pr) {
+			return
+		}
+		if pr.Title == nil || *pr.Title == "" {
+			validationFailed(w, "PullRequest", "title", "missing_field")
+			return
+		}
+		rp.lastNumber++
+		created := &github.PullRequest{
+			Number:    github.Int(rp.lastNumber),
+			State:     github.String("open"),
+			Title:     pr.Title,
+			Body:      pr.Body,
+			User:      s.user(s.login),
+			Merged:    github.Bool(false),
+			CreatedAt: nowPtr(),
+			UpdatedAt: nowPtr(),
+		}
+		rp.pulls[*created.Number] = created
+		writeJSON(w, http.StatusCreated, created)
+	}))
+	s.handle("GET", "repos/:owner/:repo/pulls/:number", s.withPull(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, pr *github.PullRequest) {
+		writeJSON(w, http.StatusOK, pr)
+	}))
+	s.handle("PATCH", "repos/:owner/:repo/pulls/:number", s.withPull(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, pr *github.PullRequest) {
+		edit := new(github.PullRequest)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Title != nil && *edit.Title == "" {
+			validationFailed(w, "PullRequest", "title", "missing_field")
+			return
+		}
+		if edit.State != nil && *edit.State != "open" && *edit.State != "closed" {
+			validationFailed(w, "PullRequest", "state", "invalid")
+			return
+		}
+		if edit.Title != nil {
+			pr.Title = edit.Title
+		}
+		if edit.Body != nil {
+			pr.Body = edit.Body
+		}
+		if edit.State != nil && *edit.State != *pr.State {
+			pr.State = edit.State
+			if *pr.State == "closed" {
+				pr.ClosedAt = nowPtr()
+			} else {
+				pr.ClosedAt = nil
+			}
+		}
+		pr.UpdatedAt = nowPtr()
+		writeJSON(w, http.StatusOK, pr)
+	}))
+}
+
+// withIssue adapts a handler that takes the issue with the number given by
+// the :number parameter, answering 404 Not Found if there is none.
+func (s *Server) withIssue(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo, i *issue)) func(http.ResponseWriter, *http.Request, params) {
+	return s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		n, _ := p.int("number")
+		i := rp.issues[n]
+		if i == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, rp, i)
+	})
+}
+
+// withPull adapts a handler that takes the pull request with the number given
+// by the :number parameter, answering 404 Not Found if there is none.
+func (s *Server) withPull(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo, pr *github.PullRequest)) func(http.ResponseWriter, *http.Request, params) {
+	return s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		n, _ := p.int("number")
+		pr := rp.pulls[n]
+		if pr == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, rp, pr)
+	})
+}
+
+// withComment adapts a handler that takes the comment with the ID given by
+// the :id parameter, answering 404 Not Found if there is none.
+func (s *Server) withComment(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo, c *comment)) func(http.ResponseWriter, *http.Request, params) {
+	return s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		id, _ := p.int("id")
+		for _, c := range rp.comments {
+			if *c.ID == id {
+				h(w, r, p, rp, c)
+				return
+			}
+		}
+		notFound(w)
+	})
+}
+
+// withLabel adapts a handler that takes the label named by the :name
+// parameter, answering 404 Not Found if there is none.
+func (s *Server) withLabel(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo, l *github.Label)) func(http.ResponseWriter, *http.Request, params) {
+	return s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		l := rp.label(p["name"])
+		if l == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, rp, l)
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestServer_issues(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	issue, _, err := client.Issues.Create(ctx, "o", "r", &github.Issue{
+		Title:    github.String("t"),
+		Assignee: &github.User{Login: github.String(DefaultLogin)},
+		Labels:   []github.Label{{Name: github.String("bug")}},
+	})
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if *issue.Number != 1 || *issue.State != "open" || *issue.User.Login != DefaultLogin || *issue.Assignee.Login != DefaultLogin {
+		t.Errorf("Issues.Create returned %+v", issue)
+	}
+	if len(issue.Labels) != 1 || *issue.Labels[0].Name != "bug" {
+		t.Errorf("Issues.Create returned labels %+v, want bug", issue.Labels)
+	}
+
+	if _, _, err := client.Issues.CreateComment(ctx, "o", "r", 1, &github.IssueComment{Body: github.String("c")}); err != nil {
+		t.Fatalf("Issues.CreateComment returned error: %v", err)
+	}
+
+	issue, _, err = client.Issues.Edit(ctx, "o", "r", 1, &github.Issue{State: github.String("closed")})
+	if err != nil {
+		t.Fatalf("Issues.Edit returned error: %v", err)
+	}
+	if *issue.State != "closed" || issue.ClosedAt == nil || *issue.Comments != 1 {
+		t.Errorf("Issues.Edit returned %+v, want closed issue with 1 comment", issue)
+	}
+
+	open, _, _ := client.Issues.ListByRepo(ctx, "o", "r", nil)
+	closed, _, _ := client.Issues.ListByRepo(ctx, "o", "r", &github.IssueListByRepoOptions{State: "closed", Labels: []string{"bug"}})
+	if len(open) != 0 || len(closed) != 1 {
+		t.Errorf("ListByRepo returned %d open and %d closed issues, want 0 and 1", len(open), len(closed))
+	}
+}
+
+func TestServer_comments(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("t")})
+	c, _, err := client.Issues.CreateComment(ctx, "o", "r", 1, &github.IssueComment{Body: github.String("a")})
+	if err != nil {
+		t.Fatalf("CreateComment returned error: %v", err)
+	}
+
+	c, _, err = client.Issues.EditComment(ctx, "o", "r", *c.ID, &github.IssueComment{Body: github.String("b")})
+	if err != nil {
+		t.Fatalf("EditComment returned error: %v", err)
+	}
+	if got, _, _ := client.Issues.GetComment(ctx, "o", "r", *c.ID); *got.Body != "b" {
+		t.Errorf("GetComment returned body %v, want b", *got.Body)
+	}
+
+	all, _, _ := client.Issues.ListComments(ctx, "o", "r", 0, nil)
+	if len(all) != 1 {
+		t.Errorf("ListComments returned %d comments, want 1", len(all))
+	}
+
+	if _, err := client.Issues.DeleteComment(ctx, "o", "r", *c.ID); err != nil {
+		t.Fatalf("DeleteComment returned error: %v", err)
+	}
+	if comments, _, _ := client.Issues.ListComments(ctx, "o", "r", 1, nil); len(comments) != 0 {
+		t.Errorf("ListComments returned %+v after delete, want none", comments)
+	}
+}
+
+func TestServer_labels(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("t")})
+	if _, _, err := client.Issues.AddLabelsToIssue(ctx, "o", "r", 1, []string{"a", "b"}); err != nil {
+		t.Fatalf("AddLabelsToIssue returned error: %v", err)
+	}
+
+	// renaming a label renames it on the issue
+	if _, _, err := client.Issues.EditLabel(ctx, "o", "r", "a", &github.Label{Name: github.String("c")}); err != nil {
+		t.Fatalf("EditLabel returned error: %v", err)
+	}
+	labels, _, _ := client.Issues.ListLabelsByIssue(ctx, "o", "r", 1, nil)
+	if got := labelNames(labels); !reflect.DeepEqual(got, []string{"c", "b"}) {
+		t.Errorf("ListLabelsByIssue returned %v, want [c b]", got)
+	}
+
+	labels, _, _ = client.Issues.ReplaceLabelsForIssue(ctx, "o", "r", 1, []string{"d"})
+	if got := labelNames(labels); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Errorf("ReplaceLabelsForIssue returned %v, want [d]", got)
+	}
+
+	if _, err := client.Issues.DeleteLabel(ctx, "o", "r", "d"); err != nil {
+		t.Fatalf("DeleteLabel returned error: %v", err)
+	}
+	labels, _, _ = client.Issues.ListLabelsByIssue(ctx, "o", "r", 1, nil)
+	if len(labels) != 0 {
+		t.Errorf("ListLabelsByIssue returned %v after delete, want none", labelNames(labels))
+	}
+	labels, _, _ = client.Issues.ListLabels(ctx, "o", "r", nil)
+	if got := labelNames(labels); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("ListLabels returned %v, want [b c]", got)
+	}
+}
+
+func labelNames(labels []github.Label) []string {
+	names := []string{}
+	for _, l := range labels {
+		names = append(names, *l.Name)
+	}
+	return names
+}
+
+func TestServer_pulls(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("issue")})
+	pull, _, err := client.PullRequests.Create(ctx, "o", "r", &github.PullRequest{Title: github.String("pull")})
+	if err != nil {
+		t.Fatalf("PullRequests.Create returned error: %v", err)
+	}
+	if *pull.Number != 2 {
+		t.Errorf("PullRequests.Create returned number %v, want 2", *pull.Number)
+	}
+
+	pull, _, err = client.PullRequests.Edit(ctx, "o", "r", 2, &github.PullRequest{State: github.String("closed")})
+	if err != nil {
+		t.Fatalf("PullRequests.Edit returned error: %v", err)
+	}
+	if *pull.State != "closed" {
+		t.Errorf("PullRequests.Edit returned state %v, want closed", *pull.State)
+	}
+
+	pulls, _, _ := client.PullRequests.List(ctx, "o", "r", &github.PullRequestListOptions{State: "closed"})
+	if len(pulls) != 1 || *pulls[0].Title != "pull" {
+		t.Errorf("PullRequests.List returned %+v, want the closed pull request", pulls)
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// repo is a repository and the resources it holds.
+type repo struct {
+	github.Repository
+
+	lastNumber int // issues and pull requests share numbers
+	issues     map[int]*issue
+	pulls      map[int]*github.PullRequest
+	comments   []*comment
+	labels     []*github.Label
+	hooks      []*github.Hook
+	statuses   map[string][]github.RepoStatus // by ref, newest first
+}
+
+// repoKey returns the key of a repository in s.repos.
+func repoKey(owner, name string) string {
+	return strings.ToLower(owner + "/" + name)
+}
+
+// AddRepo adds a repository with the given owner and name, if it does not
+// exist yet.  The owner is the organization with that login if there is one,
+// and otherwise the user with that login, which is added if needed.
+func (s *Server) AddRepo(owner, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.repos[repoKey(owner, name)] == nil {
+		s.addRepo(s.owner(owner), &github.Repository{Name: github.String(name)})
+	}
+}
+
+// owner returns the representation of the owner of a repository: the
+// organization with the given login if there is one, or else the user with
+// that login, which is added if needed.
+func (s *Server) owner(login string) *github.User {
+	if o := s.orgs[strings.ToLower(login)]; o != nil {
+		return &github.User{Login: o.Login, ID: o.ID, URL: o.URL}
+	}
+	return s.addUser(login)
+}
+
+// addRepo adds the repository described by r, owned by owner.
+func (s *Server) addRepo(owner *github.User, r *github.Repository) *repo {
+	rp := &repo{
+		Repository: *r,
+		issues:     make(map[int]*issue),
+		pulls:      make(map[int]*github.PullRequest),
+		statuses:   make(map[string][]github.RepoStatus),
+	}
+	rp.ID = github.Int(s.newID())
+	rp.Owner = owner
+	t := github.Timestamp{Time: now()}
+	rp.CreatedAt, rp.UpdatedAt, rp.PushedAt = &t, &t, &t
+	if rp.DefaultBranch == nil {
+		rp.DefaultBranch = github.String("master")
+	}
+	if rp.Private == nil {
+		rp.Private = github.Bool(false)
+	}
+	if rp.HasIssues == nil {
+		rp.HasIssues = github.Bool(true)
+	}
+	if rp.HasWiki == nil {
+		rp.HasWiki = github.Bool(true)
+	}
+
+	key := repoKey(*owner.Login, *rp.Name)
+	s.repos[key] = rp
+	s.repoOrder = append(s.repoOrder, key)
+	return rp
+}
+
+// reposOwnedBy returns the repositories owned by the given login.
+func (s *Server) reposOwnedBy(login string) []github.Repository {
+	repos := []github.Repository{}
+	for _, key := range s.repoOrder {
+		if rp := s.repos[key]; strings.EqualFold(*rp.Owner.Login, login) {
+			repos = append(repos, rp.Repository)
+		}
+	}
+	return repos
+}
+
+func (s *Server) addRepoRoutes() {
+	s.handle("GET", "user/repos", func(w http.ResponseWriter, r *http.Request, p params) {
+		writeList(w, r, s.reposOwnedBy(s.login))
+	})
+	s.handle("GET", "users/:user/repos", func(w http.ResponseWriter, r *http.Request, p params) {
+		if s.user(p["user"]) == nil {
+			notFound(w)
+			return
+		}
+		writeList(w, r, s.reposOwnedBy(p["user"]))
+	})
+	s.handle("GET", "orgs/:org/repos", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		writeList(w, r, s.reposOwnedBy(*o.Login))
+	}))
+	s.handle("GET", "repositories", func(w http.ResponseWriter, r *http.Request, p params) {
+		since, _ := strconv.Atoi(r.URL.Query().Get("since"))
+		repos := []github.Repository{}
+		for _, key := range s.repoOrder {
+			if rp := s.repos[key]; *rp.ID > since {
+				repos = append(repos, rp.Repository)
+			}
+		}
+		writeList(w, r, repos)
+	})
+
+	createRepo := func(w http.ResponseWriter, r *http.Request, owner *github.User) {
+		body := new(github.Repository)
+		if !decode(w, r, body) {
+			return
+		}
+		if body.Name == nil || *body.Name == "" {
+			validationFailed(w, "Repository", "name", "missing_field")
+			return
+		}
+		if s.repos[repoKey(*owner.Login, *body.Name)] != nil {
+			validationFailed(w, "Repository", "name", "already_exists")
+			return
+		}
+		rp := s.addRepo(owner, body)
+		writeJSON(w, http.StatusCreated, &rp.Repository)
+	}
+	s.handle("POST", "user/repos", func(w http.ResponseWriter, r *http.Request, p params) {
+		createRepo(w, r, s.user(s.login))
+	})
+	s.handle("POST", "orgs/:org/repos", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		createRepo(w, r, s.owner(*o.Login))
+	}))
+
+	s.handle("GET", "repos/:owner/:repo", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		writeJSON(w, http.StatusOK, &rp.Repository)
+	}))
+	s.handle("PATCH", "repos/:owner/:repo", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		edit := new(github.Repository)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Name != nil && !strings.EqualFold(*edit.Name, *rp.Name) {
+			if *edit.Name == "" {
+				validationFailed(w, "Repository", "name", "missing_field")
+				return
+			}
+			if s.repos[repoKey(*rp.Owner.Login, *edit.Name)] != nil {
+				validationFailed(w, "Repository", "name", "already_exists")
+				return
+			}
+			s.renameRepo(rp, *edit.Name)
+		}
+		for _, f := range []struct{ dst, src **string }{
+			{&rp.Description, &edit.Description},
+			{&rp.Homepage, &edit.Homepage},
+			{&rp.DefaultBranch, &edit.DefaultBranch},
+		} {
+			if *f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		for _, f := range []struct{ dst, src **bool }{
+			{&rp.Private, &edit.Private},
+			{&rp.HasIssues, &edit.HasIssues},
+			{&rp.HasWiki, &edit.HasWiki},
+		} {
+			if *f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		rp.UpdatedAt = &github.Timestamp{Time: now()}
+		writeJSON(w, http.StatusOK, &rp.Repository)
+	}))
+
+	s.addHookRoutes()
+	s.addStatusRoutes()
+}
+
+// renameRepo renames rp, updating every reference to it.
+func (s *Server) renameRepo(rp *repo, name string) {
+	oldKey := repoKey(*rp.Owner.Login, *rp.Name)
+	newKey := repoKey(*rp.Owner.Login, name)
+	rp.Name = github.String(name)
+
+	delete(s.repos, oldKey)
+	s.repos[newKey] = rp
+	for i, key := range s.repoOrder {
+		if key == oldKey {
+			s.repoOrder[i] = newKey
+		}
+	}
+	for _, t := range s.teams {
+		for i, key := range t.repos {
+			if key == oldKey {
+				t.repos[i] = newKey
+			}
+		}
+	}
+}
+
+func (s *Server) addHookRoutes() {
+	s.handle("GET", "repos/:owner/:repo/hooks", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		hooks := []github.Hook{}
+		for _, h := range rp.hooks {
+			hooks = append(hooks, *h)
+		}
+		writeList(w, r, hooks)
+	}))
+	s.handle("POST", "repos/:owner/:repo/hooks", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		h := new(github.Hook)
+		if !decode(w, r, h) {
+			return
+		}
+		if h.Name == nil || *h.Name == "" {
+			validationFailed(w, "Hook", "name", "missing_field")
+			return
+		}
+		if h.Config == nil {
+			validationFailed(w, "Hook", "config", "missing_field")
+			return
+		}
+		if h.Events == nil {
+			h.Events = []string{"push"}
+		}
+		if h.Active == nil {
+			h.Active = github.Bool(true)
+		}
+		h.ID = github.Int(s.newID())
+		h.CreatedAt, h.UpdatedAt = nowPtr(), nowPtr()
+		rp.hooks = append(rp.hooks, h)
+		writeJSON(w, http.StatusCreated, h)
+	}))
+	s.handle("GET", "repos/:owner/:repo/hooks/:id", s.withHook(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, h *github.Hook) {
+		writeJSON(w, http.StatusOK, h)
+	}))
+	s.handle("PATCH", "repos/:owner/:repo/hooks/:id", s.withHook(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, h *github.Hook) {
+		edit := new(github.Hook)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Events != nil {
+			h.Events = edit.Events
+		}
+		if edit.Active != nil {
+			h.Active = edit.Active
+		}
+		if edit.Config != nil {
+			h.Config = edit.Config
+		}
+		h.UpdatedAt = nowPtr()
+		writeJSON(w, http.StatusOK, h)
+	}))
+	s.handle("DELETE", "repos/:owner/:repo/hooks/:id", s.withHook(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, h *github.Hook) {
+		for i, v := range rp.hooks {
+			if v == h {
+				rp.hooks = append(rp.hooks[:i], rp.hooks[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.handle("POST", "repos/:owner/:repo/hooks/:id/tests", s.withHook(func(w http.ResponseWriter, r *http.Request, p params, rp *repo, h *github.Hook) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// validStatusStates are the possible states of a status.
+var validStatusStates = []string{"pending", "success", "error", "failure"}
+
+func (s *Server) addStatusRoutes() {
+	s.handle("GET", "repos/:owner/:repo/statuses/:ref", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		statuses := append([]github.RepoStatus{}, rp.statuses[p["ref"]]...)
+		writeList(w, r, statuses)
+	}))
+	s.handle("POST", "repos/:owner/:repo/statuses/:ref", s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		st := new(github.RepoStatus)
+		if !decode(w, r, st) {
+			return
+		}
+		if st.State == nil {
+			validationFailed(w, "Status", "state", "missing_field")
+			return
+		}
+		if !contains(validStatusStates, *st.State) {
+			validationFailed(w, "Status", "state", "invalid")
+			return
+		}
+		st.ID = github.Int(s.newID())
+		st.Creator = s.user(s.login)
+		st.CreatedAt, st.UpdatedAt = nowPtr(), nowPtr()
+		ref := p["ref"]
+		rp.statuses[ref] = append([]github.RepoStatus{*st}, rp.statuses[ref]...)
+		writeJSON(w, http.StatusCreated, st)
+	}))
+}
+
+// withRepo adapts a handler that takes the repository named by the :owner and
+// :repo parameters, answering 404 Not Found if there is none.
+func (s *Server) withRepo(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo)) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		rp := s.repos[repoKey(p["owner"], p["repo"])]
+		if rp == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, rp)
+	}
+}
+
+// withHook adapts a handler that takes the hook with the ID given by the :id
+// parameter, answering 404 Not Found if there is none.
+func (s *Server) withHook(h func(w http.ResponseWriter, r *http.Request, p params, rp *repo, h *github.Hook)) func(http.ResponseWriter, *http.Request, params) {
+	return s.withRepo(func(w http.ResponseWriter, r *http.Request, p params, rp *repo) {
+		id, _ := p.int("id")
+		for _, hook := range rp.hooks {
+			if *hook.ID == id {
+				h(w, r, p, rp, hook)
+				return
+			}
+		}
+		notFound(w)
+	})
+}
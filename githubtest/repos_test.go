@@ -0,0 +1,100 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestServer_repos(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddOrg("org")
+	client := srv.Client()
+
+	repo, _, err := client.Repositories.Create(ctx, "org", &github.Repository{Name: github.String("r")})
+	if err != nil {
+		t.Fatalf("Repositories.Create returned error: %v", err)
+	}
+	if *repo.Owner.Login != "org" || *repo.DefaultBranch != "master" || *repo.Private {
+		t.Errorf("Repositories.Create returned %+v", repo)
+	}
+
+	repo, _, err = client.Repositories.Edit(ctx, "org", "r", &github.Repository{
+		Name:        github.String("renamed"),
+		Description: github.String("d"),
+	})
+	if err != nil {
+		t.Fatalf("Repositories.Edit returned error: %v", err)
+	}
+	if *repo.Name != "renamed" || *repo.Description != "d" {
+		t.Errorf("Repositories.Edit returned %+v", repo)
+	}
+
+	if _, _, err := client.Repositories.Get(ctx, "org", "r"); !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("Repositories.Get of old name returned error %v, want a *NotFoundError", err)
+	}
+	repos, _, _ := client.Repositories.ListByOrg(ctx, "org", nil)
+	if len(repos) != 1 || *repos[0].Name != "renamed" {
+		t.Errorf("Repositories.ListByOrg returned %+v", repos)
+	}
+}
+
+func TestServer_hooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	hook, _, err := client.Repositories.CreateHook(ctx, "o", "r", &github.Hook{
+		Name:   github.String("web"),
+		Config: map[string]interface{}{"url": "http://example.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreateHook returned error: %v", err)
+	}
+	if !*hook.Active || len(hook.Events) != 1 || hook.Events[0] != "push" {
+		t.Errorf("CreateHook returned %+v, want an active push hook", hook)
+	}
+
+	hook, _, err = client.Repositories.EditHook(ctx, "o", "r", *hook.ID, &github.Hook{Active: github.Bool(false)})
+	if err != nil || *hook.Active {
+		t.Errorf("EditHook returned %+v, %v; want an inactive hook", hook, err)
+	}
+	if _, err := client.Repositories.TestHook(ctx, "o", "r", *hook.ID); err != nil {
+		t.Errorf("TestHook returned error: %v", err)
+	}
+	if _, err := client.Repositories.DeleteHook(ctx, "o", "r", *hook.ID); err != nil {
+		t.Errorf("DeleteHook returned error: %v", err)
+	}
+	if _, _, err := client.Repositories.GetHook(ctx, "o", "r", *hook.ID); !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("GetHook after delete returned error %v, want a *NotFoundError", err)
+	}
+}
+
+func TestServer_statuses(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	for _, state := range []string{"pending", "success"} {
+		if _, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "abc", &github.RepoStatus{State: github.String(state)}); err != nil {
+			t.Fatalf("CreateStatus returned error: %v", err)
+		}
+	}
+
+	statuses, _, err := client.Repositories.ListStatuses(ctx, "o", "r", "abc", nil)
+	if err != nil {
+		t.Fatalf("ListStatuses returned error: %v", err)
+	}
+	if len(statuses) != 2 || *statuses[0].State != "success" || *statuses[0].Creator.Login != DefaultLogin {
+		t.Errorf("ListStatuses returned %+v, want newest first", statuses)
+	}
+}
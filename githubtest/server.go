@@ -0,0 +1,360 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package githubtest provides an in-memory fake of the GitHub API, for testing
+code built on the github package without network access.
+
+A Server keeps the state of users, organizations, teams, repositories, issues,
+comments, labels, pull requests, hooks and statuses, so that a resource
+created through the API can be read back, edited and deleted.  List endpoints
+are paginated with Link headers, every response carries rate limit headers,
+and invalid requests are rejected with the validation errors GitHub sends:
+
+	srv := githubtest.NewServer()
+	defer srv.Close()
+	srv.AddRepo("octocat", "hello-world")
+
+	client := srv.Client()
+	issue, _, err := client.Issues.Create(ctx, "octocat", "hello-world", &github.Issue{
+		Title: github.String("found a bug"),
+	})
+
+Requests are made as the authenticated user, DefaultLogin unless changed with
+SetAuthenticatedUser; no credentials are checked.  Endpoints the Server does
+not implement answer 404 Not Found.
+
+To test against responses from the real API instead, record them once with a
+Recorder and replay them from the cassette file it writes.
+*/
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+const (
+	// DefaultLogin is the login of the user that requests are made as, unless
+	// changed with SetAuthenticatedUser.
+	DefaultLogin = "octocat"
+
+	// DefaultRateLimit is the number of requests allowed per hour, unless
+	// changed with SetRateLimit.
+	DefaultRateLimit = 5000
+
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// Server is a fake GitHub API server.  It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, without a trailing slash.
+	URL string
+
+	server *httptest.Server
+	routes []route
+
+	mu     sync.Mutex
+	login  string // authenticated user
+	nextID int
+	rate   github.Rate
+
+	users     map[string]*github.User // by lowercase login
+	userOrder []string
+	orgs      map[string]*org
+	teams     map[int]*team
+	repos     map[string]*repo // by lowercase "owner/name"
+	repoOrder []string
+}
+
+// NewServer starts and returns a new Server, with the user DefaultLogin
+// already added.  The caller should call Close when finished, to shut it
+// down.
+func NewServer() *Server {
+	s := &Server{
+		login: DefaultLogin,
+		rate:  github.Rate{Limit: DefaultRateLimit, Remaining: DefaultRateLimit},
+		users: make(map[string]*github.User),
+		orgs:  make(map[string]*org),
+		teams: make(map[int]*team),
+		repos: make(map[string]*repo),
+	}
+	s.addRoutes()
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+	s.AddUser(DefaultLogin)
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a new github.Client that sends its requests to s.
+func (s *Server) Client() *github.Client {
+	c := github.NewClient(nil)
+	u, _ := url.Parse(s.URL + "/")
+	c.BaseURL = u
+	c.UploadURL = u
+	return c
+}
+
+// SetAuthenticatedUser sets the user that requests are made as, adding it if
+// needed.
+func (s *Server) SetAuthenticatedUser(login string) {
+	s.AddUser(login)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.login = login
+}
+
+// SetRateLimit sets the number of requests allowed per hour and the number
+// remaining, and starts a new rate limit window.  Once no requests remain,
+// the server answers with 403 Forbidden until the window ends.
+func (s *Server) SetRateLimit(limit, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rate = github.Rate{Limit: limit, Remaining: remaining, Reset: now().Add(time.Hour)}
+}
+
+// ServeHTTP implements the http.Handler interface, so that a Server can also
+// be mounted in another server.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.countRequest(w) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("API rate limit exceeded for %v.", s.login))
+		return
+	}
+
+	path := strings.Trim(r.URL.Path, "/")
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+		if p, ok := rt.match(path); ok {
+			rt.handler(w, r, p)
+			return
+		}
+	}
+	notFound(w)
+}
+
+// countRequest counts a request against the rate limit, and sets the rate
+// limit headers on w.  It reports whether the request is allowed.
+func (s *Server) countRequest(w http.ResponseWriter) bool {
+	if !now().Before(s.rate.Reset) {
+		s.rate.Remaining = s.rate.Limit
+		s.rate.Reset = now().Add(time.Hour)
+	}
+	allowed := s.rate.Remaining > 0
+	if allowed {
+		s.rate.Remaining--
+	}
+
+	h := w.Header()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(s.rate.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(s.rate.Remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(s.rate.Reset.Unix(), 10))
+	return allowed
+}
+
+// newID returns a new unique ID for a resource.
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// now returns the current time at the precision of the API.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// nowPtr returns a pointer to the current time.
+func nowPtr() *time.Time {
+	t := now()
+	return &t
+}
+
+// params holds the values of the parameters in a route pattern, by name
+// without the leading colon.
+type params map[string]string
+
+// int returns the value of the named parameter as an integer.
+func (p params) int(name string) (int, bool) {
+	n, err := strconv.Atoi(p[name])
+	return n, err == nil
+}
+
+type route struct {
+	method  string
+	pattern []string
+	handler func(w http.ResponseWriter, r *http.Request, p params)
+}
+
+// handle registers handler for requests with the given method and path
+// pattern, such as "repos/:owner/:repo".  Routes are matched in the order
+// they are registered.
+func (s *Server) handle(method, pattern string, handler func(w http.ResponseWriter, r *http.Request, p params)) {
+	s.routes = append(s.routes, route{method, strings.Split(pattern, "/"), handler})
+}
+
+// match reports whether path matches the pattern of rt, and returns the
+// values of its parameters.
+func (rt route) match(path string) (params, bool) {
+	segments := strings.Split(path, "/")
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+	p := make(params)
+	for i, seg := range rt.pattern {
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			v, err := url.PathUnescape(segments[i])
+			if err != nil || v == "" {
+				return nil, false
+			}
+			p[seg[1:]] = v
+		case seg != segments[i]:
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+// addRoutes registers the handlers of every implemented endpoint.
+func (s *Server) addRoutes() {
+	s.addUserRoutes()
+	s.addOrgRoutes()
+	s.addRepoRoutes()
+	s.addIssueRoutes()
+	s.addPullRoutes()
+}
+
+// decode decodes the JSON request body into v.  If that fails, it writes a
+// 400 Bad Request response and returns false.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Problems parsing JSON")
+		return false
+	}
+	return true
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// errorBody is the body of an error response.
+type errorBody struct {
+	Message          string         `json:"message"`
+	Errors           []github.Error `json:"errors,omitempty"`
+	DocumentationURL string         `json:"documentation_url,omitempty"`
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, &errorBody{Message: message})
+}
+
+// notFound writes a 404 Not Found response.
+func notFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, &errorBody{
+		Message:          "Not Found",
+		DocumentationURL: "http://developer.github.com/v3",
+	})
+}
+
+// validationFailed writes a 422 Unprocessable Entity response, reporting
+// that field of resource failed validation with the given code, such as
+// "missing_field", "invalid" or "already_exists".
+func validationFailed(w http.ResponseWriter, resource, field, code string) {
+	writeJSON(w, 422, &errorBody{
+		Message: "Validation Failed",
+		Errors:  []github.Error{{Resource: resource, Field: field, Code: code}},
+	})
+}
+
+// writeList writes a page of list, which must be a slice, as selected by the
+// page and per_page query parameters of r.  Link headers point to the other
+// pages, as GitHub's do.
+func writeList(w http.ResponseWriter, r *http.Request, list interface{}) {
+	v := reflect.ValueOf(list)
+	q := r.URL.Query()
+
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	lastPage := (v.Len() + perPage - 1) / perPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+	start, end := (page-1)*perPage, page*perPage
+	if start > v.Len() {
+		start = v.Len()
+	}
+	if end > v.Len() {
+		end = v.Len()
+	}
+
+	var links []string
+	link := func(page int, rel string) {
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		lq := r.URL.Query()
+		lq.Set("page", strconv.Itoa(page))
+		u.RawQuery = lq.Encode()
+		links = append(links, fmt.Sprintf(`<%v>; rel="%v"`, u.String(), rel))
+	}
+	if page < lastPage {
+		link(page+1, "next")
+		link(lastPage, "last")
+	}
+	if page > 1 {
+		link(1, "first")
+		link(page-1, "prev")
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	result := reflect.MakeSlice(v.Type(), 0, end-start)
+	result = reflect.AppendSlice(result, v.Slice(start, end))
+	writeJSON(w, http.StatusOK, result.Interface())
+}
+
+// writeBool writes the response of an endpoint that checks a condition, such
+// as membership: 204 No Content if ok is true, and 404 Not Found otherwise.
+func writeBool(w http.ResponseWriter, ok bool) {
+	if ok {
+		w.WriteHeader(http.StatusNoContent)
+	} else {
+		notFound(w)
+	}
+}
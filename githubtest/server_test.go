@@ -0,0 +1,227 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+var ctx = context.Background()
+
+func TestServer_notFound(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	_, _, err := client.Repositories.Get(ctx, "o", "missing")
+	if !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("Repositories.Get returned error %v, want a *NotFoundError", err)
+	}
+
+	req, _ := client.NewRequest("GET", "unknown/endpoint", nil)
+	if _, err := client.Do(ctx, req, nil); !errors.Is(err, github.ErrNotFound) {
+		t.Errorf("Do returned error %v, want a *NotFoundError", err)
+	}
+}
+
+func TestServer_badJSON(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+
+	resp, err := http.Post(srv.URL+"/repos/o/r/issues", "application/json", nil)
+	if err != nil {
+		t.Fatalf("Post returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Status = %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	for i := 0; i < 5; i++ {
+		label := &github.Label{Name: github.String(fmt.Sprint("l", i)), Color: github.String("ff0000")}
+		if _, _, err := client.Issues.CreateLabel(ctx, "o", "r", label); err != nil {
+			t.Fatalf("CreateLabel returned error: %v", err)
+		}
+	}
+
+	labels, resp, err := client.Issues.ListLabels(ctx, "o", "r", &github.ListOptions{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListLabels returned error: %v", err)
+	}
+	if len(labels) != 2 || *labels[0].Name != "l2" {
+		t.Errorf("ListLabels page 2 returned %+v, want l2 and l3", labels)
+	}
+	if resp.FirstPage != 1 || resp.PrevPage != 1 || resp.NextPage != 3 || resp.LastPage != 3 {
+		t.Errorf("Pages = first %v, prev %v, next %v, last %v; want 1, 1, 3, 3",
+			resp.FirstPage, resp.PrevPage, resp.NextPage, resp.LastPage)
+	}
+
+	var all []github.Label
+	list := func(ctx context.Context, opt *github.ListOptions) (interface{}, *github.Response, error) {
+		return client.Issues.ListLabels(ctx, "o", "r", opt)
+	}
+	opt := &github.PaginateOptions{ListOptions: github.ListOptions{PerPage: 2}}
+	if err := client.PaginateAll(ctx, opt, list, &all); err != nil {
+		t.Fatalf("PaginateAll returned error: %v", err)
+	}
+	if len(all) != 5 {
+		t.Errorf("PaginateAll returned %d labels, want 5", len(all))
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	srv.SetRateLimit(60, 1)
+
+	_, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+	if resp.Rate.Limit != 60 || resp.Rate.Remaining != 0 || resp.Rate.Reset.IsZero() {
+		t.Errorf("Response rate = %+v, want limit 60 and remaining 0", resp.Rate)
+	}
+
+	_, _, err = client.Users.Get(ctx, "")
+	if !errors.Is(err, github.ErrRateLimit) {
+		t.Errorf("Users.Get returned error %v, want a *RateLimitError", err)
+	}
+}
+
+func TestServer_validation(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	tests := []struct {
+		call  func() error
+		field string
+		code  string
+	}{
+		{func() error {
+			_, _, err := client.Issues.Create(ctx, "o", "r", &github.Issue{})
+			return err
+		}, "title", "missing_field"},
+		{func() error {
+			_, _, err := client.Issues.Create(ctx, "o", "r", &github.Issue{
+				Title:    github.String("t"),
+				Assignee: &github.User{Login: github.String("nobody")},
+			})
+			return err
+		}, "assignee", "invalid"},
+		{func() error {
+			_, _, err := client.Issues.CreateLabel(ctx, "o", "r", &github.Label{Name: github.String("l"), Color: github.String("red")})
+			return err
+		}, "color", "invalid"},
+		{func() error {
+			_, _, err := client.Repositories.Create(ctx, "", &github.Repository{Name: github.String("r")})
+			if err != nil {
+				return err
+			}
+			_, _, err = client.Repositories.Create(ctx, "", &github.Repository{Name: github.String("r")})
+			return err
+		}, "name", "already_exists"},
+		{func() error {
+			_, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "sha", &github.RepoStatus{State: github.String("broken")})
+			return err
+		}, "state", "invalid"},
+		{func() error {
+			_, _, err := client.Repositories.CreateHook(ctx, "o", "r", &github.Hook{Config: map[string]interface{}{}})
+			return err
+		}, "name", "missing_field"},
+	}
+
+	for i, tt := range tests {
+		err := tt.call()
+		var verr *github.ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("tests[%d] returned error %v, want a *ValidationError", i, err)
+			continue
+		}
+		if len(verr.Errors) != 1 || verr.Errors[0].Field != tt.field || verr.Errors[0].Code != tt.code {
+			t.Errorf("tests[%d] returned errors %+v, want field %v with code %v", i, verr.Errors, tt.field, tt.code)
+		}
+	}
+}
+
+func TestServer_users(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	srv.SetAuthenticatedUser("me")
+	user, _, err := client.Users.Edit(ctx, &github.User{Name: github.String("Me")})
+	if err != nil {
+		t.Fatalf("Users.Edit returned error: %v", err)
+	}
+	if *user.Login != "me" || *user.Name != "Me" {
+		t.Errorf("Users.Edit returned %+v, want login me and name Me", user)
+	}
+
+	user, _, err = client.Users.Get(ctx, "ME")
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+	if *user.Name != "Me" {
+		t.Errorf("Users.Get returned %+v, want name Me", user)
+	}
+
+	users, _, err := client.Users.ListAll(ctx, nil)
+	if err != nil {
+		t.Fatalf("Users.ListAll returned error: %v", err)
+	}
+	if len(users) != 2 || *users[0].Login != DefaultLogin || *users[1].Login != "me" {
+		t.Errorf("Users.ListAll returned %+v, want %v and me", users, DefaultLogin)
+	}
+}
+
+func TestServer_concurrent(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRepo("o", "r")
+	client := srv.Client()
+
+	done := make(chan bool)
+	for i := 0; i < 10; i++ {
+		go func() {
+			client.Issues.Create(ctx, "o", "r", &github.Issue{Title: github.String("t")})
+			done <- true
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+
+	issues, _, err := client.Issues.ListByRepo(ctx, "o", "r", &github.IssueListByRepoOptions{ListOptions: github.ListOptions{PerPage: 100}})
+	if err != nil {
+		t.Fatalf("ListByRepo returned error: %v", err)
+	}
+	var numbers []int
+	for _, i := range issues {
+		numbers = append(numbers, *i.Number)
+	}
+	if want := []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}; !reflect.DeepEqual(numbers, want) {
+		t.Errorf("Issue numbers = %v, want %v", numbers, want)
+	}
+}
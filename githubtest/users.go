@@ -0,0 +1,435 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// org is an organization and its members.
+type org struct {
+	github.Organization
+	members       []string // logins, in the order they were added
+	publicMembers map[string]bool
+	teams         []int
+}
+
+// team is a team and the members and repositories it holds.
+type team struct {
+	github.Team
+	org     string
+	members []string
+	repos   []string // keys of s.repos
+}
+
+// AddUser adds a user with the given login, if it does not exist yet.
+func (s *Server) AddUser(login string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addUser(login)
+}
+
+func (s *Server) addUser(login string) *github.User {
+	key := strings.ToLower(login)
+	if u, ok := s.users[key]; ok {
+		return u
+	}
+	u := &github.User{
+		Login:     github.String(login),
+		ID:        github.Int(s.newID()),
+		URL:       github.String(s.URL + "/users/" + login),
+		CreatedAt: nowPtr(),
+	}
+	s.users[key] = u
+	s.userOrder = append(s.userOrder, key)
+	return u
+}
+
+// AddOrg adds an organization with the given login, if it does not exist yet.
+func (s *Server) AddOrg(login string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addOrg(login)
+}
+
+func (s *Server) addOrg(login string) *org {
+	key := strings.ToLower(login)
+	if o, ok := s.orgs[key]; ok {
+		return o
+	}
+	o := &org{
+		Organization: github.Organization{
+			Login:     github.String(login),
+			ID:        github.Int(s.newID()),
+			URL:       github.String(s.URL + "/orgs/" + login),
+			CreatedAt: nowPtr(),
+		},
+		publicMembers: make(map[string]bool),
+	}
+	s.orgs[key] = o
+	return o
+}
+
+// AddOrgMember adds the user with the given login to an organization, adding
+// both if needed.
+func (s *Server) AddOrgMember(orgLogin, login string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o := s.addOrg(orgLogin)
+	s.addUser(login)
+	if !containsFold(o.members, login) {
+		o.members = append(o.members, login)
+	}
+}
+
+// user returns the user with the given login, or nil.
+func (s *Server) user(login string) *github.User {
+	return s.users[strings.ToLower(login)]
+}
+
+// usersByLogin returns the users with the given logins.
+func (s *Server) usersByLogin(logins []string) []github.User {
+	users := []github.User{}
+	for _, l := range logins {
+		if u := s.user(l); u != nil {
+			users = append(users, *u)
+		}
+	}
+	return users
+}
+
+func (s *Server) addUserRoutes() {
+	s.handle("GET", "user", func(w http.ResponseWriter, r *http.Request, p params) {
+		writeJSON(w, http.StatusOK, s.user(s.login))
+	})
+
+	s.handle("PATCH", "user", func(w http.ResponseWriter, r *http.Request, p params) {
+		edit := new(github.User)
+		if !decode(w, r, edit) {
+			return
+		}
+		u := s.user(s.login)
+		for _, f := range []struct{ dst, src **string }{
+			{&u.Name, &edit.Name},
+			{&u.Email, &edit.Email},
+			{&u.Blog, &edit.Blog},
+			{&u.Company, &edit.Company},
+			{&u.Location, &edit.Location},
+		} {
+			if *f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		if edit.Hireable != nil {
+			u.Hireable = edit.Hireable
+		}
+		writeJSON(w, http.StatusOK, u)
+	})
+
+	s.handle("GET", "users", func(w http.ResponseWriter, r *http.Request, p params) {
+		since, _ := strconv.Atoi(r.URL.Query().Get("since"))
+		users := []github.User{}
+		for _, key := range s.userOrder {
+			if u := s.users[key]; *u.ID > since {
+				users = append(users, *u)
+			}
+		}
+		writeList(w, r, users)
+	})
+
+	s.handle("GET", "users/:user", func(w http.ResponseWriter, r *http.Request, p params) {
+		u := s.user(p["user"])
+		if u == nil {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, u)
+	})
+}
+
+func (s *Server) addOrgRoutes() {
+	listOrgs := func(w http.ResponseWriter, r *http.Request, login string) {
+		if s.user(login) == nil {
+			notFound(w)
+			return
+		}
+		orgs := []github.Organization{}
+		for _, o := range s.orgs {
+			if containsFold(o.members, login) {
+				orgs = append(orgs, o.Organization)
+			}
+		}
+		sortByID(orgs)
+		writeList(w, r, orgs)
+	}
+	s.handle("GET", "user/orgs", func(w http.ResponseWriter, r *http.Request, p params) {
+		listOrgs(w, r, s.login)
+	})
+	s.handle("GET", "users/:user/orgs", func(w http.ResponseWriter, r *http.Request, p params) {
+		listOrgs(w, r, p["user"])
+	})
+
+	s.handle("GET", "orgs/:org", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		writeJSON(w, http.StatusOK, &o.Organization)
+	}))
+	s.handle("PATCH", "orgs/:org", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		edit := new(github.Organization)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Location != nil {
+			o.Location = edit.Location
+		}
+		writeJSON(w, http.StatusOK, &o.Organization)
+	}))
+
+	s.handle("GET", "orgs/:org/members", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		writeList(w, r, s.usersByLogin(o.members))
+	}))
+	s.handle("GET", "orgs/:org/members/:user", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		writeBool(w, containsFold(o.members, p["user"]))
+	}))
+	s.handle("DELETE", "orgs/:org/members/:user", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		o.members = removeFold(o.members, p["user"])
+		delete(o.publicMembers, strings.ToLower(p["user"]))
+		for _, id := range o.teams {
+			t := s.teams[id]
+			t.members = removeFold(t.members, p["user"])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	s.handle("GET", "orgs/:org/public_members", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		var public []string
+		for _, m := range o.members {
+			if o.publicMembers[strings.ToLower(m)] {
+				public = append(public, m)
+			}
+		}
+		writeList(w, r, s.usersByLogin(public))
+	}))
+	s.handle("GET", "orgs/:org/public_members/:user", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		writeBool(w, o.publicMembers[strings.ToLower(p["user"])])
+	}))
+	s.handle("PUT", "orgs/:org/public_members/:user", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		if !containsFold(o.members, p["user"]) {
+			writeError(w, http.StatusForbidden, "You must be a member of this organization to publicize membership.")
+			return
+		}
+		o.publicMembers[strings.ToLower(p["user"])] = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.handle("DELETE", "orgs/:org/public_members/:user", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		delete(o.publicMembers, strings.ToLower(p["user"]))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	s.handle("GET", "orgs/:org/teams", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		teams := []github.Team{}
+		for _, id := range o.teams {
+			teams = append(teams, s.teams[id].Team)
+		}
+		writeList(w, r, teams)
+	}))
+	s.handle("POST", "orgs/:org/teams", s.withOrg(func(w http.ResponseWriter, r *http.Request, p params, o *org) {
+		t := new(team)
+		if !decode(w, r, &t.Team) {
+			return
+		}
+		if t.Name == nil || *t.Name == "" {
+			validationFailed(w, "Team", "name", "missing_field")
+			return
+		}
+		for _, id := range o.teams {
+			if strings.EqualFold(*s.teams[id].Name, *t.Name) {
+				validationFailed(w, "Team", "name", "already_exists")
+				return
+			}
+		}
+		if t.Permission == nil {
+			t.Permission = github.String("pull")
+		}
+		id := s.newID()
+		t.ID = github.Int(id)
+		t.Slug = github.String(strings.ToLower(strings.Replace(*t.Name, " ", "-", -1)))
+		t.URL = github.String(s.URL + "/teams/" + strconv.Itoa(id))
+		t.org = *o.Login
+		s.teams[id] = t
+		o.teams = append(o.teams, id)
+		writeJSON(w, http.StatusCreated, s.renderTeam(t))
+	}))
+
+	s.handle("GET", "teams/:id", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		writeJSON(w, http.StatusOK, s.renderTeam(t))
+	}))
+	s.handle("PATCH", "teams/:id", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		edit := new(github.Team)
+		if !decode(w, r, edit) {
+			return
+		}
+		if edit.Name != nil {
+			if *edit.Name == "" {
+				validationFailed(w, "Team", "name", "missing_field")
+				return
+			}
+			t.Name = edit.Name
+		}
+		if edit.Permission != nil {
+			t.Permission = edit.Permission
+		}
+		writeJSON(w, http.StatusOK, s.renderTeam(t))
+	}))
+	s.handle("DELETE", "teams/:id", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		o := s.orgs[strings.ToLower(t.org)]
+		for i, id := range o.teams {
+			if id == *t.ID {
+				o.teams = append(o.teams[:i], o.teams[i+1:]...)
+				break
+			}
+		}
+		delete(s.teams, *t.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	s.handle("GET", "teams/:id/members", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		writeList(w, r, s.usersByLogin(t.members))
+	}))
+	s.handle("GET", "teams/:id/members/:user", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		writeBool(w, containsFold(t.members, p["user"]))
+	}))
+	s.handle("PUT", "teams/:id/members/:user", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		u := s.user(p["user"])
+		if u == nil {
+			notFound(w)
+			return
+		}
+		if !containsFold(t.members, *u.Login) {
+			t.members = append(t.members, *u.Login)
+		}
+		if o := s.orgs[strings.ToLower(t.org)]; !containsFold(o.members, *u.Login) {
+			o.members = append(o.members, *u.Login)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.handle("DELETE", "teams/:id/members/:user", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		t.members = removeFold(t.members, p["user"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	s.handle("GET", "teams/:id/repos", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		repos := []github.Repository{}
+		for _, key := range t.repos {
+			repos = append(repos, s.repos[key].Repository)
+		}
+		writeList(w, r, repos)
+	}))
+	s.handle("GET", "teams/:id/repos/:owner/:repo", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		writeBool(w, contains(t.repos, repoKey(p["owner"], p["repo"])))
+	}))
+	s.handle("PUT", "teams/:id/repos/:owner/:repo", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		key := repoKey(p["owner"], p["repo"])
+		rp := s.repos[key]
+		if rp == nil {
+			notFound(w)
+			return
+		}
+		if !strings.EqualFold(*rp.Owner.Login, t.org) {
+			validationFailed(w, "TeamMember", "repo", "invalid")
+			return
+		}
+		if !contains(t.repos, key) {
+			t.repos = append(t.repos, key)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.handle("DELETE", "teams/:id/repos/:owner/:repo", s.withTeam(func(w http.ResponseWriter, r *http.Request, p params, t *team) {
+		t.repos = remove(t.repos, repoKey(p["owner"], p["repo"]))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// renderTeam returns the API representation of t.
+func (s *Server) renderTeam(t *team) *github.Team {
+	v := t.Team
+	v.MembersCount = github.Int(len(t.members))
+	v.ReposCount = github.Int(len(t.repos))
+	return &v
+}
+
+// withOrg adapts a handler that takes the organization named by the :org
+// parameter, answering 404 Not Found if there is none.
+func (s *Server) withOrg(h func(w http.ResponseWriter, r *http.Request, p params, o *org)) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		o := s.orgs[strings.ToLower(p["org"])]
+		if o == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, o)
+	}
+}
+
+// withTeam adapts a handler that takes the team with the ID given by the :id
+// parameter, answering 404 Not Found if there is none.
+func (s *Server) withTeam(h func(w http.ResponseWriter, r *http.Request, p params, t *team)) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		id, _ := p.int("id")
+		t := s.teams[id]
+		if t == nil {
+			notFound(w)
+			return
+		}
+		h(w, r, p, t)
+	}
+}
+
+// sortByID sorts orgs by ID, which is the order they were added in.
+func sortByID(orgs []github.Organization) {
+	sort.Slice(orgs, func(i, j int) bool { return *orgs[i].ID < *orgs[j].ID })
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(list []string, s string) []string {
+	var out []string
+	for _, v := range list {
+		if v != s {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func removeFold(list []string, s string) []string {
+	var out []string
+	for _, v := range list {
+		if !strings.EqualFold(v, s) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+// Copyright 2013 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestServer_orgs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddOrgMember("org", "u")
+	client := srv.Client()
+
+	orgs, _, err := client.Organizations.List(ctx, "u", nil)
+	if err != nil {
+		t.Fatalf("Organizations.List returned error: %v", err)
+	}
+	if len(orgs) != 1 || *orgs[0].Login != "org" {
+		t.Errorf("Organizations.List returned %+v", orgs)
+	}
+
+	if ok, _, _ := client.Organizations.IsMember(ctx, "org", "u"); !ok {
+		t.Errorf("IsMember returned false, want true")
+	}
+	if ok, _, _ := client.Organizations.IsPublicMember(ctx, "org", "u"); ok {
+		t.Errorf("IsPublicMember returned true, want false")
+	}
+	if _, err := client.Organizations.PublicizeMembership(ctx, "org", "u"); err != nil {
+		t.Fatalf("PublicizeMembership returned error: %v", err)
+	}
+	if members, _, _ := client.Organizations.ListMembers(ctx, "org", true, nil); len(members) != 1 {
+		t.Errorf("ListMembers(public) returned %+v, want u", members)
+	}
+
+	if _, err := client.Organizations.RemoveMember(ctx, "org", "u"); err != nil {
+		t.Fatalf("RemoveMember returned error: %v", err)
+	}
+	if ok, _, _ := client.Organizations.IsMember(ctx, "org", "u"); ok {
+		t.Errorf("IsMember after removal returned true, want false")
+	}
+}
+
+func TestServer_teams(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddOrg("org")
+	srv.AddUser("u")
+	srv.AddRepo("org", "r")
+	client := srv.Client()
+
+	team, _, err := client.Organizations.CreateTeam(ctx, "org", &github.Team{Name: github.String("Core Team")})
+	if err != nil {
+		t.Fatalf("CreateTeam returned error: %v", err)
+	}
+	if *team.Slug != "core-team" || *team.Permission != "pull" {
+		t.Errorf("CreateTeam returned %+v", team)
+	}
+
+	if _, err := client.Organizations.AddTeamMember(ctx, *team.ID, "u"); err != nil {
+		t.Fatalf("AddTeamMember returned error: %v", err)
+	}
+	if _, err := client.Organizations.AddTeamRepo(ctx, *team.ID, "org", "r"); err != nil {
+		t.Fatalf("AddTeamRepo returned error: %v", err)
+	}
+
+	team, _, _ = client.Organizations.GetTeam(ctx, *team.ID)
+	if *team.MembersCount != 1 || *team.ReposCount != 1 {
+		t.Errorf("GetTeam returned %+v, want 1 member and 1 repo", team)
+	}
+	if ok, _, _ := client.Organizations.IsMember(ctx, "org", "u"); !ok {
+		t.Errorf("Team member is not a member of the organization")
+	}
+	if ok, _, _ := client.Organizations.IsTeamRepo(ctx, *team.ID, "org", "r"); !ok {
+		t.Errorf("IsTeamRepo returned false, want true")
+	}
+
+	if _, err := client.Organizations.DeleteTeam(ctx, *team.ID); err != nil {
+		t.Fatalf("DeleteTeam returned error: %v", err)
+	}
+	if teams, _, _ := client.Organizations.ListTeams(ctx, "org", nil); len(teams) != 0 {
+		t.Errorf("ListTeams returned %+v after delete, want none", teams)
+	}
+}